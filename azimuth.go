@@ -0,0 +1,127 @@
+package UTM
+
+import "math"
+
+// GridBearing converts a true azimuth or heading in degrees at a latitude and
+// longitude to a bearing from grid north in the UTM zone of the point. It
+// subtracts the meridian convergence, see FromLatLonWithConvergence. The
+// result is in [0, 360).
+func GridBearing(latitude, longitude, azimuth float64) (bearing float64, err error) {
+	return WGS84.GridBearing(latitude, longitude, azimuth)
+}
+
+// GridBearing is GridBearing on the ellipsoid.
+func (el *Ellipsoid) GridBearing(latitude, longitude, azimuth float64) (bearing float64, err error) {
+	longitude, zoneNumber, _, err := parseFromLatLonInput(latitude, longitude, false)
+	if err != nil {
+		return
+	}
+
+	convergence, _ := el.convergenceScale(latitude, longitude, zoneNumber)
+
+	return normalizeBearing(azimuth - convergence), nil
+}
+
+// TrueAzimuth converts a grid bearing in degrees at Universal Transverse
+// Mercator coordinates to a true azimuth. It is the inverse of GridBearing.
+func TrueAzimuth(easting, northing float64, zoneNumber int, hemisphere Hemisphere, bearing float64) (
+	azimuth float64, err error,
+) {
+	return WGS84.TrueAzimuth(easting, northing, zoneNumber, hemisphere, bearing)
+}
+
+// TrueAzimuth is TrueAzimuth on the ellipsoid.
+func (el *Ellipsoid) TrueAzimuth(easting, northing float64, zoneNumber int, hemisphere Hemisphere, bearing float64) (
+	azimuth float64, err error,
+) {
+	latitude, longitude, err := el.ToLatLonHemisphere(easting, northing, zoneNumber, hemisphere)
+	if err != nil {
+		return
+	}
+
+	convergence, _ := el.convergenceScale(latitude, longitude, zoneNumber)
+
+	return normalizeBearing(bearing + convergence), nil
+}
+
+// ArcToChord returns the arc-to-chord correction t-T in degrees at the first
+// of two points in the same zone: the angle from the projected geodesic
+// between the points to the straight grid line, positive clockwise. The
+// projected geodesic bows away from the central meridian, so its grid bearing
+// T is the bearing of the line t minus the correction.
+//
+// The formula is the usual one for lines up to about 100 km, accurate to a
+// tenth of an arc second:
+//
+//	t-T = -(N2-N1) (2E1'+E2') / (6 k0² ρ ν)
+//
+// where E' is the easting from the central meridian and ρ ν the product of
+// the radii of curvature at the mean latitude.
+func ArcToChord(easting1, northing1, easting2, northing2 float64, zoneNumber int, hemisphere Hemisphere) (
+	correction float64, err error,
+) {
+	return WGS84.ArcToChord(easting1, northing1, easting2, northing2, zoneNumber, hemisphere)
+}
+
+// ArcToChord is ArcToChord on the ellipsoid.
+func (el *Ellipsoid) ArcToChord(easting1, northing1, easting2, northing2 float64, zoneNumber int, hemisphere Hemisphere) (
+	correction float64, err error,
+) {
+	if _, _, err = el.ToLatLonHemisphere(easting1, northing1, zoneNumber, hemisphere); err != nil {
+		return
+	}
+	if _, _, err = el.ToLatLonHemisphere(easting2, northing2, zoneNumber, hemisphere); err != nil {
+		return
+	}
+
+	correction = el.arcToChord(easting1, northing1, easting2, northing2, zoneNumber, hemisphere == Northern)
+
+	return
+}
+
+// arcToChord is ArcToChord without validation.
+func (el *Ellipsoid) arcToChord(easting1, northing1, easting2, northing2 float64, zoneNumber int, northern bool) float64 {
+	latitude, _ := el.toLatLon((easting1+easting2)/2, (northing1+northing2)/2, zoneNumber, northern)
+
+	x1 := easting1 - 500000
+	x2 := easting2 - 500000
+
+	return deg(-(northing2 - northing1) * (2*x1 + x2) / (6 * k0 * k0 * el.rhoNu(latitude)))
+}
+
+// LineAzimuth returns the true azimuth at the first point of the geodesic
+// between two points in the same zone. It reduces the grid bearing of the
+// straight line by the arc-to-chord correction and adds the convergence:
+// azimuth = t - (t-T) + convergence.
+func LineAzimuth(easting1, northing1, easting2, northing2 float64, zoneNumber int, hemisphere Hemisphere) (
+	azimuth float64, err error,
+) {
+	return WGS84.LineAzimuth(easting1, northing1, easting2, northing2, zoneNumber, hemisphere)
+}
+
+// LineAzimuth is LineAzimuth on the ellipsoid.
+func (el *Ellipsoid) LineAzimuth(easting1, northing1, easting2, northing2 float64, zoneNumber int, hemisphere Hemisphere) (
+	azimuth float64, err error,
+) {
+	correction, err := el.ArcToChord(easting1, northing1, easting2, northing2, zoneNumber, hemisphere)
+	if err != nil {
+		return
+	}
+
+	bearing := deg(math.Atan2(easting2-easting1, northing2-northing1))
+
+	return el.TrueAzimuth(easting1, northing1, zoneNumber, hemisphere, bearing-correction)
+}
+
+// normalizeBearing wraps a bearing in degrees to [0, 360).
+func normalizeBearing(bearing float64) float64 {
+	bearing = math.Mod(bearing, 360)
+	if bearing < 0 {
+		bearing += 360
+	}
+	if bearing >= 360 {
+		// a tiny negative bearing rounds to 360
+		bearing = 0
+	}
+	return bearing
+}
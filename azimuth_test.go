@@ -0,0 +1,94 @@
+package UTM_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestGridBearing(t *testing.T) {
+	t.Parallel()
+
+	_, _, _, _, convergence, _, err := UTM.FromLatLonWithConvergence(50.77535, 6.08389, false)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for _, azimuth := range []float64{0, 0.5, 90, 359.9} {
+		bearing, err := UTM.GridBearing(50.77535, 6.08389, azimuth)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		want := math.Mod(azimuth-convergence+360, 360)
+		if math.Abs(bearing-want) > 1e-12 {
+			t.Errorf("GridBearing(%f) = %f, want %f", azimuth, bearing, want)
+		}
+
+		easting, northing, zoneNumber, hemisphere, _ := UTM.FromLatLonHemisphere(50.77535, 6.08389)
+		back, err := UTM.TrueAzimuth(easting, northing, zoneNumber, hemisphere, bearing)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		// the round trip through ToLatLon moves the point by a fraction of a millimetre
+		if d := math.Mod(back-azimuth+540, 360) - 180; math.Abs(d) > 1e-7 {
+			t.Errorf("TrueAzimuth(%f) = %f, want %f", bearing, back, azimuth)
+		}
+	}
+
+	if _, err := UTM.GridBearing(-81, 0, 0); !errors.Is(err, UTM.ErrLatitudeOutOfRange) {
+		t.Errorf("%v is not %v", err, UTM.ErrLatitudeOutOfRange)
+	}
+}
+
+// normalSectionAzimuth is the azimuth of the normal section from the first
+// point to the second, which agrees with the geodesic to a few
+// milliarcseconds over the test lines.
+func normalSectionAzimuth(latitude1, longitude1, latitude2, longitude2 float64) float64 {
+	east, north, _ := UTM.ENU{Latitude: latitude1, Longitude: longitude1}.FromLatLon(latitude2, longitude2, 0)
+	return math.Mod(math.Atan2(east, north)*180/math.Pi+360, 360)
+}
+
+func TestArcToChord(t *testing.T) {
+	t.Parallel()
+
+	lines := []struct{ latitude1, longitude1, latitude2, longitude2 float64 }{
+		{50, 10, 50.4, 11.2}, // east of the central meridian, going north
+		{50, 7, 50.5, 7.3},   // west of the central meridian
+		{-33.8, 20.5, -34.3, 20.9},
+		{10, 12, 10.1, 13.5}, // almost east-west
+		{60.2, 14.9, 59.6, 13.5},
+	}
+
+	for _, l := range lines {
+		easting1, northing1, zoneNumber, hemisphere, _ := UTM.FromLatLonHemisphere(l.latitude1, l.longitude1)
+		easting2, northing2, err := UTM.FromLatLonZone(l.latitude2, l.longitude2, zoneNumber, "", UTM.DefaultZoneOffset, hemisphere == UTM.Northern)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		want := normalSectionAzimuth(l.latitude1, l.longitude1, l.latitude2, l.longitude2)
+
+		azimuth, err := UTM.LineAzimuth(easting1, northing1, easting2, northing2, zoneNumber, hemisphere)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		// a tenth of an arc second
+		if math.Abs(azimuth-want)*3600 > 0.1 {
+			t.Errorf("%v: azimuth %.7f, want %.7f", l, azimuth, want)
+		}
+
+		correction, _ := UTM.ArcToChord(easting1, northing1, easting2, northing2, zoneNumber, hemisphere)
+		if math.Abs(correction)*3600 < 1 {
+			t.Errorf("%v: correction %f\" too small to test the sign", l, correction*3600)
+		}
+	}
+
+	if _, err := UTM.ArcToChord(500000, 5000000, 500000, 5000000, 61, UTM.Northern); !errors.Is(err, UTM.ErrZoneNumberOutOfRange) {
+		t.Errorf("%v is not %v", err, UTM.ErrZoneNumberOutOfRange)
+	}
+}
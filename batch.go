@@ -0,0 +1,148 @@
+package UTM
+
+import "math"
+
+// centralLonRad holds the central meridian of every zone in radians,
+// centralLonRad[0] is unused.
+var centralLonRad = func() (table [61]float64) {
+	for zoneNumber := 1; zoneNumber <= 60; zoneNumber++ {
+		table[zoneNumber] = rad(float64(zoneNumberToCentralLongitude(zoneNumber)))
+	}
+	return
+}()
+
+// FromLatLonSlice convert many latitudes and longitudes to Universal Transverse Mercator coordinates.
+// The results go to the caller provided slices, which must all have the same
+// length as latitudes. bands receives the latitude band letter of each point.
+// It does not allocate for valid points.
+// A point which can't be converted doesn't abort the batch: its easting and
+// northing are NaN, its zone number and band 0, and, if errs is not nil, its
+// error is stored at the same index. failed counts such points. err is only
+// set when the slice lengths differ, in which case nothing is converted.
+func FromLatLonSlice(
+	latitudes, longitudes []float64,
+	eastings, northings []float64,
+	zoneNumbers []int,
+	bands []byte,
+	errs []error) (
+	failed int, err error,
+) {
+	return WGS84.FromLatLonSlice(latitudes, longitudes, eastings, northings, zoneNumbers, bands, errs)
+}
+
+// FromLatLonSlice is FromLatLonSlice on the ellipsoid.
+func (el *Ellipsoid) FromLatLonSlice(
+	latitudes, longitudes []float64,
+	eastings, northings []float64,
+	zoneNumbers []int,
+	bands []byte,
+	errs []error) (
+	failed int, err error,
+) {
+	n := len(latitudes)
+	if len(longitudes) != n || len(eastings) != n || len(northings) != n ||
+		len(zoneNumbers) != n || len(bands) != n || errs != nil && len(errs) != n {
+		return 0, InputError{Err: ErrLengthMismatch}
+	}
+
+	for i, latitude := range latitudes {
+		longitude := longitudes[i]
+
+		if e := ValidateLatLone(latitude, longitude); e != nil {
+			eastings[i], northings[i], zoneNumbers[i], bands[i] = math.NaN(), math.NaN(), 0, 0
+			if errs != nil {
+				errs[i] = e
+			}
+			failed++
+			continue
+		}
+
+		longitude = normalizeLongitude(longitude)
+		zoneNumber := latLonToZoneNumber(latitude, longitude)
+
+		x, y := el.snyder.forward(rad(latitude), rad(longitude)-centralLonRad[zoneNumber])
+
+		northing := k0 * y
+		if latitude < 0 {
+			northing += 10000000
+		}
+
+		eastings[i] = k0*x + 500000
+		northings[i] = northing
+		zoneNumbers[i] = zoneNumber
+		bands[i] = latitudeToZoneLetter(latitude)[0]
+		if errs != nil {
+			errs[i] = nil
+		}
+	}
+
+	return
+}
+
+// ToLatLonSlice convert many Universal Transverse Mercator coordinates to latitudes and longitudes.
+// The results go to the caller provided slices, which must all have the same
+// length as eastings. It does not allocate for valid points.
+// Errors are handled as in FromLatLonSlice, latitude and longitude of a
+// failed point are NaN.
+func ToLatLonSlice(
+	eastings, northings []float64,
+	zoneNumbers []int,
+	hemispheres []Hemisphere,
+	latitudes, longitudes []float64,
+	errs []error) (
+	failed int, err error,
+) {
+	return WGS84.ToLatLonSlice(eastings, northings, zoneNumbers, hemispheres, latitudes, longitudes, errs)
+}
+
+// ToLatLonSlice is ToLatLonSlice on the ellipsoid.
+func (el *Ellipsoid) ToLatLonSlice(
+	eastings, northings []float64,
+	zoneNumbers []int,
+	hemispheres []Hemisphere,
+	latitudes, longitudes []float64,
+	errs []error) (
+	failed int, err error,
+) {
+	n := len(eastings)
+	if len(northings) != n || len(zoneNumbers) != n || len(hemispheres) != n ||
+		len(latitudes) != n || len(longitudes) != n || errs != nil && len(errs) != n {
+		return 0, InputError{Err: ErrLengthMismatch}
+	}
+
+	for i, easting := range eastings {
+		northing := northings[i]
+		zoneNumber := zoneNumbers[i]
+		hemisphere := hemispheres[i]
+
+		var e error
+		if !hemisphere.Valid() {
+			e = rangeError(ErrHemisphereOutOfRange, FieldHemisphere, float64(hemisphere), float64(Northern), float64(Southern))
+		} else {
+			e = validateUTM(easting, northing, zoneNumber)
+		}
+
+		if e != nil {
+			latitudes[i], longitudes[i] = math.NaN(), math.NaN()
+			if errs != nil {
+				errs[i] = e
+			}
+			failed++
+			continue
+		}
+
+		if hemisphere == Southern {
+			northing -= 10000000
+		}
+
+		latRad, dLonRad := el.snyder.inverse((easting-500000)/k0, northing/k0)
+
+		latitudes[i] = deg(latRad)
+		longitudes[i] = deg(dLonRad + centralLonRad[zoneNumber])
+		if errs != nil {
+			errs[i] = nil
+		}
+	}
+
+	return
+}
@@ -0,0 +1,155 @@
+package UTM_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestFromLatLonSlice(t *testing.T) {
+	t.Parallel()
+
+	values := getTestValues()
+	n := len(values) + 1
+
+	latitudes := make([]float64, n)
+	longitudes := make([]float64, n)
+	for i, data := range values {
+		latitudes[i], longitudes[i] = data.LatLon.Latitude, data.LatLon.Longitude
+	}
+	// out of range
+	latitudes[n-1] = 85
+
+	eastings := make([]float64, n)
+	northings := make([]float64, n)
+	zoneNumbers := make([]int, n)
+	bands := make([]byte, n)
+	errs := make([]error, n)
+
+	failed, err := UTM.FromLatLonSlice(latitudes, longitudes, eastings, northings, zoneNumbers, bands, errs)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if failed != 1 {
+		t.Errorf("failed = %d, want 1", failed)
+	}
+
+	for i, data := range values {
+		easting, northing, zoneNumber, zoneLetter, err := UTM.FromLatLon(data.LatLon.Latitude, data.LatLon.Longitude, false)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(eastings[i]-easting) > 1e-6 || math.Abs(northings[i]-northing) > 1e-6 {
+			t.Errorf("case %d: (%f, %f), want (%f, %f)", i, eastings[i], northings[i], easting, northing)
+		}
+
+		if zoneNumbers[i] != zoneNumber || string(bands[i]) != zoneLetter || errs[i] != nil {
+			t.Errorf("case %d: zone %d%c, error %v", i, zoneNumbers[i], bands[i], errs[i])
+		}
+	}
+
+	if !errors.Is(errs[n-1], UTM.ErrLatitudeOutOfRange) {
+		t.Errorf("error %v", errs[n-1])
+	}
+
+	if !math.IsNaN(eastings[n-1]) || !math.IsNaN(northings[n-1]) || zoneNumbers[n-1] != 0 || bands[n-1] != 0 {
+		t.Errorf("failed point (%f, %f, %d, %d)", eastings[n-1], northings[n-1], zoneNumbers[n-1], bands[n-1])
+	}
+}
+
+func TestToLatLonSlice(t *testing.T) {
+	t.Parallel()
+
+	values := getTestValues()
+	n := len(values) + 1
+
+	eastings := make([]float64, n)
+	northings := make([]float64, n)
+	zoneNumbers := make([]int, n)
+	hemispheres := make([]UTM.Hemisphere, n)
+	for i, data := range values {
+		eastings[i], northings[i], zoneNumbers[i] = data.UTM.Easting, data.UTM.Northing, data.UTM.ZoneNumber
+		hemispheres[i] = UTM.HemisphereOf(data.LatLon.Latitude)
+	}
+	// out of range
+	eastings[n-1], northings[n-1], zoneNumbers[n-1], hemispheres[n-1] = 99999, 0, 1, UTM.Northern
+
+	latitudes := make([]float64, n)
+	longitudes := make([]float64, n)
+
+	// errs is optional
+	failed, err := UTM.ToLatLonSlice(eastings, northings, zoneNumbers, hemispheres, latitudes, longitudes, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if failed != 1 {
+		t.Errorf("failed = %d, want 1", failed)
+	}
+
+	for i := range values {
+		latitude, longitude, err := UTM.ToLatLonHemisphere(eastings[i], northings[i], zoneNumbers[i], hemispheres[i])
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(latitudes[i]-latitude) > 1e-9 || math.Abs(longitudes[i]-longitude) > 1e-9 {
+			t.Errorf("case %d: (%f, %f), want (%f, %f)", i, latitudes[i], longitudes[i], latitude, longitude)
+		}
+	}
+
+	if !math.IsNaN(latitudes[n-1]) || !math.IsNaN(longitudes[n-1]) {
+		t.Errorf("failed point (%f, %f)", latitudes[n-1], longitudes[n-1])
+	}
+}
+
+func TestSliceLengthMismatch(t *testing.T) {
+	t.Parallel()
+
+	s := make([]float64, 2)
+
+	_, err := UTM.FromLatLonSlice(s, s, s, s[:1], make([]int, 2), make([]byte, 2), nil)
+	if !errors.Is(err, UTM.ErrLengthMismatch) {
+		t.Errorf("FromLatLonSlice: %v", err)
+	}
+
+	_, err = UTM.ToLatLonSlice(s, s, make([]int, 2), make([]UTM.Hemisphere, 2), s, s, make([]error, 1))
+	if !errors.Is(err, UTM.ErrLengthMismatch) {
+		t.Errorf("ToLatLonSlice: %v", err)
+	}
+}
+
+func TestSliceAllocations(t *testing.T) {
+	latitudes := []float64{50.77535, 40.71435, -41.28646, 64.83778}
+	longitudes := []float64{6.08389, -74.00597, 174.77624, -147.71639}
+	n := len(latitudes)
+
+	eastings := make([]float64, n)
+	northings := make([]float64, n)
+	zoneNumbers := make([]int, n)
+	bands := make([]byte, n)
+	hemispheres := make([]UTM.Hemisphere, n)
+	errs := make([]error, n)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = UTM.FromLatLonSlice(latitudes, longitudes, eastings, northings, zoneNumbers, bands, errs)
+	})
+	if allocs != 0 {
+		t.Errorf("FromLatLonSlice allocates %f times", allocs)
+	}
+
+	for i, latitude := range latitudes {
+		hemispheres[i] = UTM.HemisphereOf(latitude)
+	}
+
+	allocs = testing.AllocsPerRun(100, func() {
+		_, _ = UTM.ToLatLonSlice(eastings, northings, zoneNumbers, hemispheres, latitudes, longitudes, errs)
+	})
+	if allocs != 0 {
+		t.Errorf("ToLatLonSlice allocates %f times", allocs)
+	}
+}
@@ -0,0 +1,297 @@
+// Command utm converts between latitude/longitude and UTM coordinates.
+//
+// Usage:
+//
+//	utm [-format plain|csv|json] [coordinate]
+//
+// The coordinate is given as arguments, otherwise every line of the standard
+// input is converted. Put -- in front of a negative latitude argument.
+// A line is either a UTM coordinate in one of the notations of UTM.ParsePoint
+//
+//	30V 377486 6296562
+//	30 V 377486mE 6296562mN
+//	30 north 377486 6296562
+//
+// or a latitude and longitude in decimal degrees or degrees, minutes and
+// seconds
+//
+//	50.77535 6.08389
+//	-33.92487, 18.42406
+//	50°46'31.26"N 6°5'2.00"E
+//
+// and is converted to the other one. Lines that can't be converted are
+// reported on the standard error and make utm exit with status 1.
+//
+// The plain format prints just the converted coordinate, csv and json print
+// latitude, longitude, easting, northing, zone number and zone letter of every
+// line, json as one object per line.
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/im7mortal/UTM"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// result is a converted line.
+type result struct {
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	Easting    float64 `json:"easting"`
+	Northing   float64 `json:"northing"`
+	ZoneNumber int     `json:"zone_number"`
+	ZoneLetter string  `json:"zone_letter"`
+
+	toUTM bool
+}
+
+// run is main with its environment made explicit. It returns the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("utm", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	format := flags.String("format", "plain", "output format: plain, csv or json")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: utm [-format plain|csv|json] [coordinate]")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	var write func(result) error
+	switch *format {
+	case "plain":
+		write = func(r result) error {
+			var err error
+			if r.toUTM {
+				_, err = fmt.Fprintf(stdout, "%d%s %.3f %.3f\n", r.ZoneNumber, r.ZoneLetter, r.Easting, r.Northing)
+			} else {
+				_, err = fmt.Fprintf(stdout, "%.8f %.8f\n", r.Latitude, r.Longitude)
+			}
+			return err
+		}
+	case "csv":
+		w := csv.NewWriter(stdout)
+		write = func(r result) error {
+			w.Write([]string{
+				strconv.FormatFloat(r.Latitude, 'f', -1, 64),
+				strconv.FormatFloat(r.Longitude, 'f', -1, 64),
+				strconv.FormatFloat(r.Easting, 'f', -1, 64),
+				strconv.FormatFloat(r.Northing, 'f', -1, 64),
+				strconv.Itoa(r.ZoneNumber),
+				r.ZoneLetter,
+			})
+			w.Flush()
+			return w.Error()
+		}
+	case "json":
+		enc := json.NewEncoder(stdout)
+		write = func(r result) error {
+			return enc.Encode(r)
+		}
+	default:
+		fmt.Fprintf(stderr, "utm: unknown format %q\n", *format)
+		flags.Usage()
+		return 2
+	}
+
+	status := 0
+
+	convertLine := func(name, line string) error {
+		r, err := convert(line)
+		if err != nil {
+			fmt.Fprintf(stderr, "utm: %s: %v\n", name, err)
+			status = 1
+			return nil
+		}
+		return write(r)
+	}
+
+	if flags.NArg() > 0 {
+		if err := convertLine("argument", strings.Join(flags.Args(), " ")); err != nil {
+			fmt.Fprintf(stderr, "utm: %v\n", err)
+			return 1
+		}
+		return status
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if err := convertLine("line "+strconv.Itoa(n), line); err != nil {
+			fmt.Fprintf(stderr, "utm: %v\n", err)
+			return 1
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(stderr, "utm: %v\n", err)
+		return 1
+	}
+
+	return status
+}
+
+// convert converts a UTM coordinate to a latitude and longitude or the other
+// way around.
+func convert(line string) (r result, err error) {
+	p, err := UTM.ParsePoint(line)
+	if err == nil {
+		var g UTM.Geodetic
+		if g, err = p.Geodetic(); err != nil {
+			return
+		}
+
+		r.Latitude, r.Longitude = g.Latitude, g.Longitude
+		r.Easting, r.Northing, r.ZoneNumber = p.Easting, p.Northing, p.ZoneNumber
+
+		if p.Band == 0 {
+			// look up the band the hemisphere stands for
+			if q, err := g.Point(); err == nil {
+				p.Band = q.Band
+			}
+		}
+		r.ZoneLetter = string(p.Band)
+
+		return
+	}
+
+	if !errors.Is(err, UTM.ErrInvalidPoint) {
+		return
+	}
+
+	r.Latitude, r.Longitude, err = parseLatLon(line)
+	if err != nil {
+		return
+	}
+
+	r.Easting, r.Northing, r.ZoneNumber, r.ZoneLetter, err = UTM.FromLatLon(r.Latitude, r.Longitude, false)
+	r.toUTM = true
+	return
+}
+
+func isSeparator(r rune) bool {
+	return unicode.IsSpace(r) || r == ',' || r == ';'
+}
+
+// parseLatLon parses a latitude and a longitude in decimal degrees or in
+// degrees, minutes and seconds. The longitude may come first if both carry a
+// hemisphere letter.
+func parseLatLon(line string) (latitude, longitude float64, err error) {
+	first, firstHemisphere, rest, err := scanAngle(line)
+	if err != nil {
+		return
+	}
+	second, secondHemisphere, rest, err := scanAngle(rest)
+	if err != nil {
+		return
+	}
+	if strings.TrimFunc(rest, isSeparator) != "" {
+		err = fmt.Errorf("unexpected %q after coordinate", strings.TrimFunc(rest, isSeparator))
+		return
+	}
+
+	if isEastWest(firstHemisphere) && isNorthSouth(secondHemisphere) {
+		first, second = second, first
+		firstHemisphere, secondHemisphere = secondHemisphere, firstHemisphere
+	}
+
+	if isEastWest(firstHemisphere) || isNorthSouth(secondHemisphere) {
+		err = errors.New("latitude must be N or S, longitude E or W")
+		return
+	}
+
+	return first, second, nil
+}
+
+func isNorthSouth(hemisphere byte) bool { return hemisphere == 'N' || hemisphere == 'S' }
+
+func isEastWest(hemisphere byte) bool { return hemisphere == 'E' || hemisphere == 'W' }
+
+// Unit symbols of degrees, minutes and seconds.
+var angleUnits = [3]string{"°d:", "'′m:", "\"″s"}
+
+// scanAngle reads an angle such as "-74.00597", "40°42'51.66\"N",
+// "N 40°42.861'" or "40d42m51.66s". The result is negative for S and W.
+func scanAngle(s string) (angle float64, hemisphere byte, rest string, err error) {
+	s = strings.TrimLeftFunc(s, isSeparator)
+	if s == "" {
+		err = errors.New("missing coordinate")
+		return
+	}
+
+	if c := s[0]; strings.IndexByte("NSEW", c) >= 0 {
+		hemisphere = c
+		s = strings.TrimLeftFunc(s[1:], unicode.IsSpace)
+	}
+
+	sign := 1.0
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		if s[0] == '-' {
+			sign = -1
+		}
+		s = s[1:]
+	}
+
+	scale := 1.0
+	for part := 0; part < 3; part++ {
+		end := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsDigit(r) && r != '.' })
+		if end < 0 {
+			end = len(s)
+		}
+		if end == 0 {
+			if part == 0 {
+				err = fmt.Errorf("invalid coordinate %q", s)
+				return
+			}
+			break
+		}
+
+		var value float64
+		if value, err = strconv.ParseFloat(s[:end], 64); err != nil {
+			err = fmt.Errorf("invalid coordinate %q", s[:end])
+			return
+		}
+		angle += value / scale
+		scale *= 60
+		s = strings.TrimLeftFunc(s[end:], unicode.IsSpace)
+
+		unit, size := utf8.DecodeRuneInString(s)
+		if s == "" || !strings.ContainsRune(angleUnits[part], unit) {
+			break
+		}
+		s = strings.TrimLeftFunc(s[size:], unicode.IsSpace)
+	}
+
+	if hemisphere == 0 && s != "" {
+		if c := unicode.ToUpper(rune(s[0])); strings.ContainsRune("NSEW", c) {
+			hemisphere = byte(c)
+			s = s[1:]
+		}
+	}
+
+	if hemisphere == 'S' || hemisphere == 'W' {
+		sign = -sign
+	}
+
+	return sign * angle, hemisphere, s, nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		args   []string
+		stdin  string
+		stdout string
+		status int
+	}{
+		{[]string{"30V", "377486", "6296562"}, "", "56.79680137 -5.00600624\n", 0},
+		{[]string{"--", "-33.92487,", "18.42406"}, "", "34H 261877.816 6243185.589\n", 0},
+		{nil, "30 V 377486 6296562\n\n40.71435 -74.00597\n", "56.79680137 -5.00600624\n18T 583959.959 4507523.087\n", 0},
+		{nil, "33N 389000E 5819000N\n34 south 261878 6243186\n", "52.50980606 13.36441398\n-33.92486634 18.42406209\n", 0},
+		{nil, "50.77535 6.08389\n30V 99999 6296562\nfoo\n", "32U 294408.917 5628897.998\n", 1},
+		{[]string{"-format", "csv", "30V", "377486", "6296562"}, "", "56.796801369094766,-5.006006236031487,377486,6296562,30,V\n", 0},
+		{
+			[]string{"-format", "json"},
+			"-41.28646 174.77624\n",
+			`{"latitude":-41.28646,"longitude":174.77624,"easting":313784.3058117406,"northing":5427057.321820429,"zone_number":60,"zone_letter":"G"}` + "\n",
+			0,
+		},
+		{[]string{"-format", "xml"}, "", "", 2},
+	}
+
+	for i, c := range cases {
+		var stdout, stderr bytes.Buffer
+
+		status := run(c.args, strings.NewReader(c.stdin), &stdout, &stderr)
+
+		if status != c.status {
+			t.Errorf("case %d: status %d, want %d (%s)", i, status, c.status, stderr.String())
+		}
+
+		if stdout.String() != c.stdout {
+			t.Errorf("case %d: output %q, want %q", i, stdout.String(), c.stdout)
+		}
+
+		if (status == 0) != (stderr.Len() == 0) {
+			t.Errorf("case %d: status %d with error output %q", i, status, stderr.String())
+		}
+	}
+}
+
+func TestParseLatLon(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		line                string
+		latitude, longitude float64
+	}{
+		{"40.71435 -74.00597", 40.71435, -74.00597},
+		{"40.71435,-74.00597", 40.71435, -74.00597},
+		{`40°42'51.66"N 74°0'21.49"W`, 40.714350, -74.005969},
+		{`74°0'21.49"W 40°42'51.66"N`, 40.714350, -74.005969},
+		{"N 40°42.861' W 74°0.35817'", 40.714350, -74.005969},
+		{"40d42m51.66s 74d0m21.49sw", 40.714350, -74.005969},
+		{"33°55′29.53″S, 18°25′26.62″E", -33.924869, 18.424061},
+	}
+
+	for i, c := range cases {
+		latitude, longitude, err := parseLatLon(c.line)
+		if err != nil {
+			t.Errorf("case %d: %v", i, err)
+			continue
+		}
+
+		if math.Abs(latitude-c.latitude) > 1e-6 || math.Abs(longitude-c.longitude) > 1e-6 {
+			t.Errorf("case %d: (%f, %f), want (%f, %f)", i, latitude, longitude, c.latitude, c.longitude)
+		}
+	}
+
+	for _, line := range []string{"", "40.7", "40.7 -74.0 12", "40.7E 74.0N 1", "74.0E 40.7E", "x 1"} {
+		if _, _, err := parseLatLon(line); err == nil {
+			t.Errorf("%q: no error", line)
+		}
+	}
+}
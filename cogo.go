@@ -0,0 +1,157 @@
+package UTM
+
+import "math"
+
+// Traverse computes the end of a survey line from its start, the horizontal
+// ground distance in metres, the true azimuth in degrees at the start and the
+// mean ellipsoidal height of the line in metres.
+//
+// The ground distance is reduced to the ellipsoid with the elevation factor
+// R/(R+h) and to the grid with the line scale factor, the grid bearing is the
+// azimuth minus the convergence plus the arc-to-chord correction. Both depend
+// on the end point and are iterated. The end point is returned in the zone it
+// falls into, with its band. An end point in another zone or hemisphere is
+// projected with FromLatLonKrueger, as TraverseInverse reprojects it with
+// ReprojectZone.
+func Traverse(start Point, distance, azimuth, height float64) (end Point, err error) {
+	return WGS84.Traverse(start, distance, azimuth, height)
+}
+
+// Traverse is Traverse on the ellipsoid.
+func (el *Ellipsoid) Traverse(start Point, distance, azimuth, height float64) (end Point, err error) {
+	if err = start.Validate(); err != nil {
+		return
+	}
+
+	northern := start.Hemisphere == Northern
+
+	latitude, longitude := el.toLatLon(start.Easting, start.Northing, start.ZoneNumber, northern)
+	convergence, _ := el.convergenceScale(latitude, longitude, start.ZoneNumber)
+	bearing := azimuth - convergence
+
+	easting, northing := start.Easting, start.Northing
+	for i := 0; i < 4; i++ {
+		combined := el.combinedScale(start.Easting, start.Northing, easting, northing, start.ZoneNumber, northern, height)
+		correction := el.arcToChord(start.Easting, start.Northing, easting, northing, start.ZoneNumber, northern)
+
+		sin, cos := math.Sincos(rad(bearing + correction))
+		easting = start.Easting + distance*combined*sin
+		northing = start.Northing + distance*combined*cos
+	}
+
+	// A change of zone goes through the Krüger series, which TraverseInverse
+	// reprojects with, so that the two close exactly.
+	tm := el.utm(start.ZoneNumber, northern)
+	latRad, dLonRad := tm.inverse(easting, northing)
+	latitude, longitude = deg(latRad), normalizeLongitude(tm.CentralMeridian+deg(dLonRad))
+
+	if err = ValidateLatLone(latitude, longitude); err != nil {
+		return
+	}
+
+	if latLonToZoneNumber(latitude, longitude) != start.ZoneNumber || HemisphereOf(latitude) != start.Hemisphere {
+		var zoneLetter string
+		easting, northing, end.ZoneNumber, zoneLetter, err = el.FromLatLonKrueger(latitude, longitude, false)
+		if err != nil {
+			return
+		}
+
+		end.Easting, end.Northing, end.Band, end.Hemisphere = easting, northing, zoneLetter[0], HemisphereOf(latitude)
+
+		return
+	}
+
+	end = Point{easting, northing, start.ZoneNumber, latitudeToZoneLetter(latitude)[0], start.Hemisphere}
+
+	return
+}
+
+// TraverseInverse computes the horizontal ground distance in metres and the
+// true azimuth in degrees at the start of the survey line between two points
+// at a mean ellipsoidal height. It is the inverse of Traverse. An end point in
+// another zone or hemisphere is reprojected into the zone of the start.
+func TraverseInverse(start, end Point, height float64) (distance, azimuth float64, err error) {
+	return WGS84.TraverseInverse(start, end, height)
+}
+
+// TraverseInverse is TraverseInverse on the ellipsoid.
+func (el *Ellipsoid) TraverseInverse(start, end Point, height float64) (distance, azimuth float64, err error) {
+	if err = start.Validate(); err != nil {
+		return
+	}
+	if err = end.Validate(); err != nil {
+		return
+	}
+
+	northern := start.Hemisphere == Northern
+
+	easting, northing := end.Easting, end.Northing
+	if end.ZoneNumber != start.ZoneNumber || end.Hemisphere != start.Hemisphere {
+		easting, northing, err = el.ReprojectZone(end.Easting, end.Northing, end.ZoneNumber, end.Hemisphere == Northern,
+			start.ZoneNumber, northern)
+		if err != nil {
+			return
+		}
+	}
+
+	combined := el.combinedScale(start.Easting, start.Northing, easting, northing, start.ZoneNumber, northern, height)
+	distance = math.Hypot(easting-start.Easting, northing-start.Northing) / combined
+
+	correction := el.arcToChord(start.Easting, start.Northing, easting, northing, start.ZoneNumber, northern)
+	bearing := deg(math.Atan2(easting-start.Easting, northing-start.Northing))
+
+	latitude, longitude := el.toLatLon(start.Easting, start.Northing, start.ZoneNumber, northern)
+	convergence, _ := el.convergenceScale(latitude, longitude, start.ZoneNumber)
+
+	azimuth = normalizeBearing(bearing - correction + convergence)
+
+	return
+}
+
+// CombinedScaleFactor returns the ratio of a grid distance to the horizontal
+// ground distance at a point and ellipsoidal height: the point scale factor
+// times the elevation factor R/(R+h), where R is the Gaussian mean radius.
+func CombinedScaleFactor(latitude, longitude, height float64) (float64, error) {
+	return WGS84.CombinedScaleFactor(latitude, longitude, height)
+}
+
+// CombinedScaleFactor is CombinedScaleFactor on the ellipsoid.
+func (el *Ellipsoid) CombinedScaleFactor(latitude, longitude, height float64) (float64, error) {
+	longitude, zoneNumber, _, err := parseFromLatLonInput(latitude, longitude, false)
+	if err != nil {
+		return 0, err
+	}
+
+	_, scale := el.convergenceScale(latitude, longitude, zoneNumber)
+	r := math.Sqrt(el.rhoNu(latitude))
+
+	return scale * r / (r + height), nil
+}
+
+// combinedScale returns the combined scale factor of a line: the line scale
+// factor by Simpson's rule over the scale at its ends and middle times the
+// elevation factor at its mean latitude.
+func (el *Ellipsoid) combinedScale(easting1, northing1, easting2, northing2 float64, zoneNumber int, northern bool, height float64) float64 {
+	scale := func(easting, northing float64) float64 {
+		latitude, longitude := el.toLatLon(easting, northing, zoneNumber, northern)
+		_, k := el.convergenceScale(latitude, longitude, zoneNumber)
+		return k
+	}
+
+	eastingM, northingM := (easting1+easting2)/2, (northing1+northing2)/2
+	lineScale := (scale(easting1, northing1) + 4*scale(eastingM, northingM) + scale(easting2, northing2)) / 6
+
+	latitude, _ := el.toLatLon(eastingM, northingM, zoneNumber, northern)
+	r := math.Sqrt(el.rhoNu(latitude))
+
+	return lineScale * r / (r + height)
+}
+
+// rhoNu returns the product of the radii of curvature in the meridian and the
+// prime vertical at a latitude, the square of the Gaussian mean radius.
+func (el *Ellipsoid) rhoNu(latitude float64) float64 {
+	sinLat := math.Sin(rad(latitude))
+	w := 1 - el.snyder.e*sinLat*sinLat
+
+	return el.a * el.a * (1 - el.snyder.e) / (w * w)
+}
@@ -0,0 +1,138 @@
+package UTM_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestTraverse(t *testing.T) {
+	t.Parallel()
+
+	start, err := UTM.Geodetic{Latitude: 50.77535, Longitude: 6.08389}.Point()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for _, azimuth := range []float64{0, 37, 90, 200, 315} {
+		end, err := UTM.Traverse(start, 10000, azimuth, 0)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		// the chord between the points on the ellipsoid is shorter than the
+		// line by s³/24R², 1 mm at 10 km
+		g, _ := end.Geodetic()
+		east, north, up := UTM.ENU{Latitude: 50.77535, Longitude: 6.08389}.FromLatLon(g.Latitude, g.Longitude, 0)
+		chord := math.Sqrt(east*east + north*north + up*up)
+		if math.Abs(chord+0.001-10000) > 0.005 {
+			t.Errorf("azimuth %f: chord %f, want 9999.999", azimuth, chord)
+		}
+
+		normalSection := math.Mod(math.Atan2(east, north)*180/math.Pi+360, 360)
+		if d := math.Mod(normalSection-azimuth+540, 360) - 180; math.Abs(d)*3600 > 0.1 {
+			t.Errorf("azimuth %f: normal section azimuth %f", azimuth, normalSection)
+		}
+
+		distance, back, err := UTM.TraverseInverse(start, end, 0)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		// the line at 315 ends in zone 31 and closes as well as the others
+		if math.Abs(distance-10000) > 1e-6 || math.Abs(math.Mod(back-azimuth+540, 360)-180)*3600 > 1e-4 {
+			t.Errorf("azimuth %f: inverse (%f, %f)", azimuth, distance, back)
+		}
+	}
+}
+
+func TestTraverseHeight(t *testing.T) {
+	t.Parallel()
+
+	start, _ := UTM.Geodetic{Latitude: -33.9, Longitude: 18.42}.Point()
+
+	// a line at 1000 m is longer on the ground than on the ellipsoid
+	high, err := UTM.Traverse(start, 10000, 60, 1000)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	low, _ := UTM.Traverse(start, 10000*6372000/(6372000+1000.0), 60, 0)
+	if math.Abs(high.Easting-low.Easting) > 0.01 || math.Abs(high.Northing-low.Northing) > 0.01 {
+		t.Errorf("%v, want %v", high, low)
+	}
+
+	distance, azimuth, err := UTM.TraverseInverse(start, high, 1000)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(distance-10000) > 1e-4 || math.Abs(azimuth-60) > 1e-5 {
+		t.Errorf("inverse (%f, %f), want (10000, 60)", distance, azimuth)
+	}
+}
+
+func TestTraverseZones(t *testing.T) {
+	t.Parallel()
+
+	// from zone 32 across the boundary at 12 E into zone 33
+	start, _ := UTM.Geodetic{Latitude: 48.5, Longitude: 11.9}.Point()
+
+	end, err := UTM.Traverse(start, 30000, 80, 500)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if end.ZoneNumber != 33 || end.Band != 'U' || end.Hemisphere != UTM.Northern {
+		t.Errorf("end point %v not in 33U", end)
+	}
+
+	distance, azimuth, err := UTM.TraverseInverse(start, end, 500)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(distance-30000) > 1e-6 || math.Abs(azimuth-80)*3600 > 1e-4 {
+		t.Errorf("inverse (%f, %f), want (30000, 80)", distance, azimuth)
+	}
+
+	// across the equator
+	start, _ = UTM.Geodetic{Latitude: 0.05, Longitude: 33}.Point()
+
+	end, err = UTM.Traverse(start, 20000, 180, 0)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if end.Hemisphere != UTM.Southern {
+		t.Errorf("end point %v not in the south", end)
+	}
+
+	if distance, _, err = UTM.TraverseInverse(start, end, 0); err != nil || math.Abs(distance-20000) > 1e-6 {
+		t.Errorf("inverse distance %f, %v", distance, err)
+	}
+
+	if _, err := UTM.Traverse(UTM.Point{Easting: 500000, Northing: 0, ZoneNumber: 61, Hemisphere: UTM.Northern}, 1, 0, 0); !errors.Is(err, UTM.ErrZoneNumberOutOfRange) {
+		t.Errorf("%v is not %v", err, UTM.ErrZoneNumberOutOfRange)
+	}
+}
+
+func TestCombinedScaleFactor(t *testing.T) {
+	t.Parallel()
+
+	// on the central meridian at sea level only the UTM scale factor applies
+	k, err := UTM.CombinedScaleFactor(45, 9, 0)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if math.Abs(k-0.9996) > 1e-12 {
+		t.Errorf("k = %.12f, want 0.9996", k)
+	}
+
+	// a kilometre up reduces it by about 157 ppm
+	if k, _ = UTM.CombinedScaleFactor(45, 9, 1000); math.Abs(k-0.9996*(1-157e-6)) > 1e-6 {
+		t.Errorf("k = %.9f at 1000 m", k)
+	}
+}
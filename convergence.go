@@ -0,0 +1,102 @@
+package UTM
+
+import "math"
+
+// convergenceScale returns the meridian convergence in radians and the point
+// scale factor, at unit scale on the central meridian, for a latitude and a
+// longitude offset from the central meridian in radians. The series are
+// truncated at the same order as forward.
+func (s *snyder) convergenceScale(latRad, dLonRad float64) (gamma, k float64) {
+	latSin := math.Sin(latRad)
+	latCos := math.Cos(latRad)
+
+	latTan := latSin / latCos
+	latTan2 := latTan * latTan
+
+	c := s.eP2 * latCos * latCos
+	c2 := c * c
+
+	a := latCos * dLonRad
+	a2 := a * a
+	a4 := a2 * a2
+	a6 := a4 * a2
+
+	gamma = dLonRad * latSin * (1 +
+		a2/3*(1+3*c+2*c2) +
+		a4/15*(2-latTan2))
+
+	k = 1 +
+		a2/2*(1+c) +
+		a4/24*(5-4*latTan2+42*c+13*c2-28*s.eP2) +
+		a6/720*(61-148*latTan2+16*latTan2*latTan2)
+
+	return
+}
+
+// FromLatLonWithConvergence convert a latitude and longitude to Universal Transverse Mercator coordinates
+// like FromLatLon and also returns the meridian convergence and the point
+// scale factor at the point.
+// The convergence is the angle in degrees from true north to grid north,
+// positive clockwise, so a grid bearing is the true azimuth minus the
+// convergence. The scale factor is the ratio of a grid distance to the
+// ellipsoidal distance, 0.9996 on the central meridian.
+func FromLatLonWithConvergence(latitude, longitude float64, northern bool) (
+	easting, northing float64, zoneNumber int, zoneLetter string, convergence, scale float64, err error,
+) {
+	return WGS84.FromLatLonWithConvergence(latitude, longitude, northern)
+}
+
+// FromLatLonWithConvergence is FromLatLonWithConvergence on the ellipsoid.
+func (el *Ellipsoid) FromLatLonWithConvergence(latitude, longitude float64, northern bool) (
+	easting, northing float64, zoneNumber int, zoneLetter string, convergence, scale float64, err error,
+) {
+	easting, northing, zoneNumber, zoneLetter, err = el.FromLatLon(latitude, longitude, northern)
+	if err != nil {
+		return
+	}
+
+	convergence, scale = el.convergenceScale(latitude, longitude, zoneNumber)
+
+	return
+}
+
+// ToLatLonWithConvergence convert Universal Transverse Mercator coordinates to a latitude and longitude
+// like ToLatLon and also returns the meridian convergence and the point scale
+// factor at the point. See FromLatLonWithConvergence.
+func ToLatLonWithConvergence(
+	easting, northing float64,
+	zoneNumber int,
+	zoneLetter string,
+	northern ...bool) (
+	latitude, longitude, convergence, scale float64, err error,
+) {
+	return WGS84.ToLatLonWithConvergence(easting, northing, zoneNumber, zoneLetter, northern...)
+}
+
+// ToLatLonWithConvergence is ToLatLonWithConvergence on the ellipsoid.
+func (el *Ellipsoid) ToLatLonWithConvergence(
+	easting, northing float64,
+	zoneNumber int,
+	zoneLetter string,
+	northern ...bool) (
+	latitude, longitude, convergence, scale float64, err error,
+) {
+	latitude, longitude, err = el.ToLatLon(easting, northing, zoneNumber, zoneLetter, northern...)
+	if err != nil {
+		return
+	}
+
+	convergence, scale = el.convergenceScale(latitude, longitude, zoneNumber)
+
+	return
+}
+
+// convergenceScale returns the convergence in degrees and the UTM point scale
+// factor of a point in the given zone.
+func (el *Ellipsoid) convergenceScale(latitude, longitude float64, zoneNumber int) (convergence, scale float64) {
+	dLon := normalizeLongitude(longitude - float64(zoneNumberToCentralLongitude(zoneNumber)))
+
+	gamma, k := el.snyder.convergenceScale(rad(latitude), rad(dLon))
+
+	return deg(gamma), k0 * k
+}
@@ -0,0 +1,107 @@
+package UTM_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestFromLatLonWithConvergenceCentralMeridian(t *testing.T) {
+	t.Parallel()
+
+	_, _, _, _, convergence, scale, err := UTM.FromLatLonWithConvergence(45, 9, false)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if convergence != 0 || math.Abs(scale-0.9996) > 1e-15 {
+		t.Errorf("convergence %f, scale %f on the central meridian", convergence, scale)
+	}
+}
+
+// TestFromLatLonWithConvergence compares against finite differences of the
+// Krüger series: a step to true north shows the convergence on the grid, a
+// step to the east the scale factor.
+func TestFromLatLonWithConvergence(t *testing.T) {
+	t.Parallel()
+
+	const step = 1e-6 // degrees
+
+	e2 := UTM.WGS84.EccentricitySquared()
+	a := UTM.WGS84.SemiMajorAxis()
+
+	for latitude := -79.0; latitude <= 83; latitude += 3.7 {
+		for longitude := -3.0; longitude <= 9; longitude += 1.1 {
+			_, _, zoneNumber, zoneLetter, convergence, scale, err := UTM.FromLatLonWithConvergence(latitude, longitude, false)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			e0, n0, err := UTM.FromLatLonZone(latitude, longitude, zoneNumber, zoneLetter, UTM.DefaultZoneOffset)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			eNorth, nNorth, err := UTM.FromLatLonZone(latitude+step, longitude, zoneNumber, zoneLetter, UTM.DefaultZoneOffset)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			eEast, nEast, err := UTM.FromLatLonZone(latitude, longitude+step, zoneNumber, zoneLetter, UTM.DefaultZoneOffset)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			wantConvergence := math.Atan2(-(eNorth-e0), nNorth-n0) * 180 / math.Pi
+
+			latRad := latitude * math.Pi / 180
+			nu := a / math.Sqrt(1-e2*math.Sin(latRad)*math.Sin(latRad))
+			ground := nu * math.Cos(latRad) * step * math.Pi / 180
+			wantScale := math.Hypot(eEast-e0, nEast-n0) / ground
+
+			if math.Abs(convergence-wantConvergence) > 1e-6 {
+				t.Errorf("(%f, %f): convergence %.9f, want %.9f", latitude, longitude, convergence, wantConvergence)
+			}
+
+			if math.Abs(scale-wantScale) > 1e-6 {
+				t.Errorf("(%f, %f): scale %.9f, want %.9f", latitude, longitude, scale, wantScale)
+			}
+		}
+	}
+}
+
+func TestToLatLonWithConvergence(t *testing.T) {
+	t.Parallel()
+
+	for i, data := range getTestValues() {
+		easting, northing, zoneNumber, zoneLetter, convergence, scale, err := UTM.FromLatLonWithConvergence(data.LatLon.Latitude, data.LatLon.Longitude, false)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		latitude, longitude, c, s, err := UTM.ToLatLonWithConvergence(easting, northing, zoneNumber, zoneLetter)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(latitude-data.LatLon.Latitude) > 1e-6 || math.Abs(longitude-data.LatLon.Longitude) > 1e-6 {
+			t.Errorf("case %d: round trip (%f, %f)", i, latitude, longitude)
+		}
+
+		if math.Abs(c-convergence) > 1e-6 || math.Abs(s-scale) > 1e-9 {
+			t.Errorf("case %d: convergence %f and scale %f, want %f and %f", i, c, s, convergence, scale)
+		}
+
+		// Convergence is positive east of the central meridian in the north.
+		east := easting > 500000
+		north := data.LatLon.Latitude > 0
+		if (convergence > 0) != (east == north) {
+			t.Errorf("case %d: convergence sign %f", i, convergence)
+		}
+	}
+
+	if _, _, _, _, err := UTM.ToLatLonWithConvergence(99999, 5628898, 32, "U"); err == nil {
+		t.Error("Expected error. easting out of range")
+	}
+}
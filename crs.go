@@ -0,0 +1,113 @@
+package UTM
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// CRS is a UTM coordinate reference system: one zone and hemisphere on a
+// reference ellipsoid. A nil Ellipsoid stands for WGS84.
+type CRS struct {
+	ZoneNumber int
+	Hemisphere Hemisphere
+	Ellipsoid  *Ellipsoid
+}
+
+// ellipsoid returns the ellipsoid of the CRS.
+func (c CRS) ellipsoid() *Ellipsoid {
+	if c.Ellipsoid == nil {
+		return WGS84
+	}
+	return c.Ellipsoid
+}
+
+// String returns the name of the CRS as used by EPSG, e.g.
+// "WGS 84 / UTM zone 33N" or "ETRS89 / UTM zone 32N". Other ellipsoids are
+// named after the ellipsoid.
+func (c CRS) String() string {
+	return c.datum().geographic + " / " + c.zoneName()
+}
+
+// zoneName returns e.g. "UTM zone 33N".
+func (c CRS) zoneName() string {
+	suffix := "N"
+	if c.Hemisphere == Southern {
+		suffix = "S"
+	}
+	return "UTM zone " + strconv.Itoa(c.ZoneNumber) + suffix
+}
+
+// Validate checks zone number and hemisphere.
+func (c CRS) Validate() error {
+	if !(1 <= c.ZoneNumber && c.ZoneNumber <= 60) {
+		return rangeError(ErrZoneNumberOutOfRange, FieldZoneNumber, float64(c.ZoneNumber), 1, 60)
+	}
+
+	if !c.Hemisphere.Valid() {
+		return rangeError(ErrHemisphereOutOfRange, FieldHemisphere, float64(c.Hemisphere), float64(Northern), float64(Southern))
+	}
+
+	return nil
+}
+
+// ParseCRS parses a CRS given as PROJ string, WKT or EPSG code, see
+// ParsePROJ, ParseWKT and ParseEPSG.
+func ParseCRS(s string) (CRS, error) {
+	t := strings.TrimSpace(s)
+
+	switch {
+	case strings.HasPrefix(t, "+") || strings.Contains(t, "proj="):
+		return ParsePROJ(t)
+	case strings.ContainsAny(t, "[("):
+		return ParseWKT(t)
+	default:
+		return ParseEPSG(t)
+	}
+}
+
+// ToLatLon convert Universal Transverse Mercator coordinates in the CRS to a latitude and longitude.
+// It uses the Krüger series like ToLatLonKrueger.
+func (c CRS) ToLatLon(easting, northing float64) (latitude, longitude float64, err error) {
+	if err = c.Validate(); err != nil {
+		return
+	}
+
+	return c.ellipsoid().ToLatLonKrueger(easting, northing, c.ZoneNumber, "", c.Hemisphere == Northern)
+}
+
+// FromLatLon convert a latitude and longitude to Universal Transverse Mercator coordinates in the CRS.
+// The point is projected into the zone of the CRS even if it falls into a
+// neighbouring one, as FromLatLonZone does with DefaultZoneOffset.
+func (c CRS) FromLatLon(latitude, longitude float64) (easting, northing float64, err error) {
+	if err = c.Validate(); err != nil {
+		return
+	}
+
+	return c.ellipsoid().fromLatLonZone(latitude, longitude, c.ZoneNumber, c.Hemisphere == Northern, DefaultZoneOffset)
+}
+
+// predefinedEllipsoids are matched by shape when a CRS definition is parsed.
+var predefinedEllipsoids = []*Ellipsoid{WGS84, GRS80, International1924, Clarke1866, Bessel1841, Krassovsky1940, Airy1830}
+
+// lookupEllipsoid returns the predefined ellipsoid with the shape, or a new
+// one named name.
+func lookupEllipsoid(name string, semiMajorAxis, inverseFlattening float64) (*Ellipsoid, error) {
+	for _, el := range predefinedEllipsoids {
+		if math.Abs(el.a-semiMajorAxis) < 1e-6 && math.Abs(el.invF-inverseFlattening) < 1e-9 {
+			return el, nil
+		}
+	}
+
+	el, err := NewEllipsoid(name, semiMajorAxis, inverseFlattening)
+	if err != nil {
+		return nil, inputError(ErrInvalidCRS, FieldNone, err.Error())
+	}
+
+	return el, nil
+}
+
+// sameAs reports whether two ellipsoids have the same shape.
+func (el *Ellipsoid) sameAs(other *Ellipsoid) bool {
+	return el.a == other.a && el.invF == other.invF
+}
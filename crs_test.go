@@ -0,0 +1,63 @@
+package UTM_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestCRS(t *testing.T) {
+	t.Parallel()
+
+	for i, data := range getTestValues() {
+		crs := UTM.CRS{ZoneNumber: data.UTM.ZoneNumber, Hemisphere: UTM.HemisphereOf(data.LatLon.Latitude)}
+
+		easting, northing, err := crs.FromLatLon(data.LatLon.Latitude, data.LatLon.Longitude)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if round(easting) != data.UTM.Easting || round(northing) != data.UTM.Northing {
+			t.Errorf("case %d: (%f, %f), want %v", i, easting, northing, data.UTM)
+		}
+
+		latitude, longitude, err := crs.ToLatLon(easting, northing)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(latitude-data.LatLon.Latitude) > 1e-9 || math.Abs(longitude-data.LatLon.Longitude) > 1e-9 {
+			t.Errorf("case %d: (%f, %f), want %v", i, latitude, longitude, data.LatLon)
+		}
+	}
+
+	// a point of zone 33 in zone 32
+	crs := UTM.CRS{ZoneNumber: 32, Hemisphere: UTM.Northern, Ellipsoid: UTM.GRS80}
+	if _, _, err := crs.FromLatLon(52.5, 13.4); err != nil {
+		t.Errorf("FromLatLon: %v", err)
+	}
+
+	if _, _, err := (UTM.CRS{ZoneNumber: 32}).ToLatLon(500000, 0); !errors.Is(err, UTM.ErrHemisphereOutOfRange) {
+		t.Errorf("ToLatLon: %v", err)
+	}
+}
+
+func TestCRSString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		crs  UTM.CRS
+		want string
+	}{
+		{UTM.CRS{ZoneNumber: 33, Hemisphere: UTM.Northern}, "WGS 84 / UTM zone 33N"},
+		{UTM.CRS{ZoneNumber: 1, Hemisphere: UTM.Southern, Ellipsoid: UTM.International1924}, "International 1924 / UTM zone 1S"},
+	}
+
+	for i, c := range cases {
+		if s := c.crs.String(); s != c.want {
+			t.Errorf("case %d: %q, want %q", i, s, c.want)
+		}
+	}
+}
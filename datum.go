@@ -0,0 +1,211 @@
+package UTM
+
+import "math"
+
+// arcSecond is the size of an arc second in radians.
+const arcSecond = xr / 3600
+
+// Helmert is a 7-parameter similarity transformation of geocentric
+// coordinates in the position vector convention (EPSG method 9606):
+//
+//	X' = T + (1 + S) R X
+//
+// Translations are in metres, rotations in arc seconds and the scale change S
+// in parts per million. Parameters published in the coordinate frame
+// convention (EPSG method 9607) have the signs of the rotations reversed.
+type Helmert struct {
+	TX, TY, TZ float64
+	RX, RY, RZ float64
+	Scale      float64
+}
+
+// matrix returns (1 + S) R with the small angle rotation matrix R.
+func (h Helmert) matrix() [3][3]float64 {
+	s := 1 + h.Scale*1e-6
+	rx, ry, rz := h.RX*arcSecond, h.RY*arcSecond, h.RZ*arcSecond
+
+	return [3][3]float64{
+		{s, -s * rz, s * ry},
+		{s * rz, s, -s * rx},
+		{-s * ry, s * rx, s},
+	}
+}
+
+// Transform applies the transformation to geocentric coordinates.
+func (h Helmert) Transform(x, y, z float64) (float64, float64, float64) {
+	m := h.matrix()
+
+	return h.TX + m[0][0]*x + m[0][1]*y + m[0][2]*z,
+		h.TY + m[1][0]*x + m[1][1]*y + m[1][2]*z,
+		h.TZ + m[2][0]*x + m[2][1]*y + m[2][2]*z
+}
+
+// InverseTransform undoes Transform exactly. Negating the parameters is only
+// an approximation, off by centimetres for the larger published sets.
+func (h Helmert) InverseTransform(x, y, z float64) (float64, float64, float64) {
+	m := h.matrix()
+	x, y, z = x-h.TX, y-h.TY, z-h.TZ
+
+	// inverse of m by cofactors
+	c00 := m[1][1]*m[2][2] - m[1][2]*m[2][1]
+	c01 := m[0][2]*m[2][1] - m[0][1]*m[2][2]
+	c02 := m[0][1]*m[1][2] - m[0][2]*m[1][1]
+	c10 := m[1][2]*m[2][0] - m[1][0]*m[2][2]
+	c11 := m[0][0]*m[2][2] - m[0][2]*m[2][0]
+	c12 := m[0][2]*m[1][0] - m[0][0]*m[1][2]
+	c20 := m[1][0]*m[2][1] - m[1][1]*m[2][0]
+	c21 := m[0][1]*m[2][0] - m[0][0]*m[2][1]
+	c22 := m[0][0]*m[1][1] - m[0][1]*m[1][0]
+
+	det := m[0][0]*c00 + m[0][1]*c10 + m[0][2]*c20
+
+	return (c00*x + c01*y + c02*z) / det,
+		(c10*x + c11*y + c12*z) / det,
+		(c20*x + c21*y + c22*z) / det
+}
+
+// Molodensky shifts a latitude, longitude and ellipsoidal height from one
+// ellipsoid to another with the standard Molodensky formulas. dx, dy and dz
+// are the geocentric translations in metres from the source to the target
+// datum. It agrees with a 3-parameter Helmert transformation to a few
+// decimetres and needs no geocentric round trip.
+func Molodensky(latitude, longitude, height float64, from, to *Ellipsoid, dx, dy, dz float64) (
+	lat, lon, h float64,
+) {
+	a := from.a
+	f := from.Flattening()
+	e2 := from.snyder.e
+	da := to.a - a
+	df := to.Flattening() - f
+
+	sinLat, cosLat := math.Sincos(rad(latitude))
+	sinLon, cosLon := math.Sincos(rad(longitude))
+
+	w := 1 - e2*sinLat*sinLat
+	// radii of curvature in the prime vertical and the meridian
+	rn := a / math.Sqrt(w)
+	rm := a * (1 - e2) / (w * math.Sqrt(w))
+	bOverA := 1 - f
+
+	dLat := (-dx*sinLat*cosLon - dy*sinLat*sinLon + dz*cosLat +
+		da*rn*e2*sinLat*cosLat/a +
+		df*(rm/bOverA+rn*bOverA)*sinLat*cosLat) / (rm + height)
+
+	dLon := (-dx*sinLon + dy*cosLon) / ((rn + height) * cosLat)
+
+	dH := dx*cosLat*cosLon + dy*cosLat*sinLon + dz*sinLat -
+		da*a/rn + df*bOverA*rn*sinLat*sinLat
+
+	return latitude + deg(dLat), longitude + deg(dLon), height + dH
+}
+
+// Datum is a geodetic datum: the ellipsoid latitudes and longitudes refer to
+// and the Helmert transformation from the datum to WGS84.
+type Datum struct {
+	Name      string
+	Ellipsoid *Ellipsoid
+	ToWGS84   Helmert
+}
+
+// Predefined datums. The parameter sets are the commonly used ones from the
+// EPSG dataset; each is accurate to a few metres in the area of the datum,
+// OSGB36 to a few metres over Great Britain.
+var (
+	DatumWGS84 = &Datum{Name: "WGS 84", Ellipsoid: WGS84}
+	// ETRS89 is the same as WGS84 at the metre level.
+	DatumETRS89 = &Datum{Name: "ETRS89", Ellipsoid: GRS80}
+	OSGB36      = &Datum{
+		Name:      "OSGB 1936",
+		Ellipsoid: Airy1830,
+		ToWGS84:   Helmert{446.448, -125.157, 542.060, 0.1502, 0.2470, 0.8421, -20.4894},
+	}
+	ED50 = &Datum{
+		Name:      "European Datum 1950",
+		Ellipsoid: International1924,
+		ToWGS84:   Helmert{TX: -87, TY: -98, TZ: -121},
+	}
+	NAD27 = &Datum{
+		Name:      "North American Datum 1927",
+		Ellipsoid: Clarke1866,
+		ToWGS84:   Helmert{TX: -8, TY: 160, TZ: 176},
+	}
+	Pulkovo1942 = &Datum{
+		Name:      "Pulkovo 1942",
+		Ellipsoid: Krassovsky1940,
+		ToWGS84:   Helmert{TX: 28, TY: -130, TZ: -95},
+	}
+	DHDN = &Datum{
+		Name:      "Deutsches Hauptdreiecksnetz",
+		Ellipsoid: Bessel1841,
+		ToWGS84:   Helmert{598.1, 73.7, 418.2, 0.202, 0.045, -2.455, 6.7},
+	}
+)
+
+func (d *Datum) String() string { return d.Name }
+
+// ToWGS84LatLon shifts a latitude, longitude and ellipsoidal height on the
+// datum to WGS84 through geocentric coordinates.
+func (d *Datum) ToWGS84LatLon(latitude, longitude, height float64) (lat, lon, h float64) {
+	x, y, z := d.Ellipsoid.LatLonToECEF(latitude, longitude, height)
+	x, y, z = d.ToWGS84.Transform(x, y, z)
+	return WGS84.ECEFToLatLon(x, y, z)
+}
+
+// FromWGS84LatLon shifts a WGS84 latitude, longitude and ellipsoidal height
+// to the datum. It is the inverse of ToWGS84LatLon.
+func (d *Datum) FromWGS84LatLon(latitude, longitude, height float64) (lat, lon, h float64) {
+	x, y, z := WGS84.LatLonToECEF(latitude, longitude, height)
+	x, y, z = d.ToWGS84.InverseTransform(x, y, z)
+	return d.Ellipsoid.ECEFToLatLon(x, y, z)
+}
+
+// Transform shifts a latitude, longitude and ellipsoidal height from the
+// datum to another one, through WGS84.
+func (d *Datum) Transform(to *Datum, latitude, longitude, height float64) (lat, lon, h float64) {
+	x, y, z := d.Ellipsoid.LatLonToECEF(latitude, longitude, height)
+	x, y, z = d.ToWGS84.Transform(x, y, z)
+	x, y, z = to.ToWGS84.InverseTransform(x, y, z)
+	return to.Ellipsoid.ECEFToLatLon(x, y, z)
+}
+
+// MolodenskyToWGS84 shifts a latitude, longitude and ellipsoidal height on the
+// datum to WGS84 with Molodensky. Only the translations of the datum are used.
+func (d *Datum) MolodenskyToWGS84(latitude, longitude, height float64) (lat, lon, h float64) {
+	return Molodensky(latitude, longitude, height, d.Ellipsoid, WGS84, d.ToWGS84.TX, d.ToWGS84.TY, d.ToWGS84.TZ)
+}
+
+// FromLatLon shifts a latitude and longitude on the datum to WGS84 and
+// converts the result to WGS84 Universal Transverse Mercator coordinates.
+// The point is assumed to lie on the ellipsoid of the datum. See the package
+// level FromLatLon for northern.
+func (d *Datum) FromLatLon(latitude, longitude float64, northern bool) (
+	easting, northing float64, zoneNumber int, zoneLetter string, err error,
+) {
+	if err = ValidateLatLone(latitude, longitude); err != nil {
+		return
+	}
+
+	latitude, longitude, _ = d.ToWGS84LatLon(latitude, longitude, 0)
+
+	return WGS84.FromLatLon(latitude, longitude, northern)
+}
+
+// ToLatLon converts WGS84 Universal Transverse Mercator coordinates to a
+// latitude and longitude and shifts them to the datum. See the package level
+// ToLatLon for the arguments.
+func (d *Datum) ToLatLon(
+	easting, northing float64,
+	zoneNumber int,
+	zoneLetter string,
+	northern ...bool) (
+	latitude, longitude float64, err error,
+) {
+	latitude, longitude, err = WGS84.ToLatLon(easting, northing, zoneNumber, zoneLetter, northern...)
+	if err != nil {
+		return
+	}
+
+	latitude, longitude, _ = d.FromWGS84LatLon(latitude, longitude, 0)
+
+	return
+}
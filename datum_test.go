@@ -0,0 +1,109 @@
+package UTM_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestHelmert(t *testing.T) {
+	t.Parallel()
+
+	// WGS 72 to WGS 84, example of EPSG guidance note 7-2, method 9606
+	h := UTM.Helmert{TZ: 4.5, RZ: 0.554, Scale: 0.219}
+
+	x, y, z := h.Transform(3657660.66, 255768.55, 5201382.11)
+	if math.Abs(x-3657660.78) > 1e-2 || math.Abs(y-255778.43) > 1e-2 || math.Abs(z-5201387.75) > 1e-2 {
+		t.Errorf("(%.3f, %.3f, %.3f), want (3657660.78, 255778.43, 5201387.75)", x, y, z)
+	}
+
+	x, y, z = h.InverseTransform(x, y, z)
+	if math.Abs(x-3657660.66) > 1e-8 || math.Abs(y-255768.55) > 1e-8 || math.Abs(z-5201382.11) > 1e-8 {
+		t.Errorf("inverse (%.9f, %.9f, %.9f)", x, y, z)
+	}
+}
+
+func TestMolodensky(t *testing.T) {
+	t.Parallel()
+
+	// WGS 84 to ED50, example of EPSG guidance note 7-2, method 9604
+	latitude := 53 + 48/60.0 + 33.820/3600
+	longitude := 2 + 7/60.0 + 46.380/3600
+
+	lat, lon, h := UTM.Molodensky(latitude, longitude, 73, UTM.WGS84, UTM.International1924, 84.87, 96.49, 116.95)
+
+	wantLat := 53 + 48/60.0 + 36.565/3600
+	wantLon := 2 + 7/60.0 + 51.477/3600
+	if math.Abs(lat-wantLat) > 0.002/3600 || math.Abs(lon-wantLon) > 0.002/3600 || math.Abs(h-28.02) > 0.01 {
+		t.Errorf("(%.9f, %.9f, %.3f), want (%.9f, %.9f, 28.02)", lat, lon, h, wantLat, wantLon)
+	}
+}
+
+func TestDatumRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, d := range []*UTM.Datum{UTM.DatumWGS84, UTM.OSGB36, UTM.ED50, UTM.NAD27, UTM.Pulkovo1942, UTM.DHDN} {
+		lat, lon, h := d.ToWGS84LatLon(52, 13, 100)
+		lat, lon, h = d.FromWGS84LatLon(lat, lon, h)
+
+		if math.Abs(lat-52) > 1e-11 || math.Abs(lon-13) > 1e-11 || math.Abs(h-100) > 1e-6 {
+			t.Errorf("%s: (%.12f, %.12f, %f)", d, lat, lon, h)
+		}
+	}
+}
+
+func TestDatumMolodensky(t *testing.T) {
+	t.Parallel()
+
+	// Molodensky and the translation only Helmert transformation agree closely
+	for _, d := range []*UTM.Datum{UTM.ED50, UTM.NAD27, UTM.Pulkovo1942} {
+		lat, lon, _ := d.ToWGS84LatLon(48, 11, 0)
+		molLat, molLon, _ := d.MolodenskyToWGS84(48, 11, 0)
+
+		if math.Abs(lat-molLat) > 1e-5 || math.Abs(lon-molLon) > 1e-5 {
+			t.Errorf("%s: Helmert (%f, %f), Molodensky (%f, %f)", d, lat, lon, molLat, molLon)
+		}
+	}
+}
+
+func TestDatumOSGB36(t *testing.T) {
+	t.Parallel()
+
+	// Greenwich Observatory: the OSGB36 prime meridian lies about 100 m west
+	// of the WGS84 one (-0.0015 deg) and latitudes differ by about 2 arc seconds.
+	lat, lon, _ := UTM.OSGB36.ToWGS84LatLon(51.477500, 0, 0)
+
+	if math.Abs(lon+0.0015) > 0.0003 || math.Abs((lat-51.4775)*3600-0.2) > 2.5 {
+		t.Errorf("(%f, %f)", lat, lon)
+	}
+}
+
+func TestDatumFromLatLon(t *testing.T) {
+	t.Parallel()
+
+	easting, northing, zoneNumber, zoneLetter, err := UTM.ED50.FromLatLon(41, 3, false)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	lat, lon, _ := UTM.ED50.ToWGS84LatLon(41, 3, 0)
+	wantEasting, wantNorthing, _, _, _ := UTM.FromLatLon(lat, lon, false)
+
+	if easting != wantEasting || northing != wantNorthing || zoneNumber != 31 || zoneLetter != "T" {
+		t.Errorf("(%f, %f, %d, %s), want (%f, %f, 31, T)", easting, northing, zoneNumber, zoneLetter, wantEasting, wantNorthing)
+	}
+
+	latitude, longitude, err := UTM.ED50.ToLatLon(easting, northing, zoneNumber, zoneLetter)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(latitude-41) > 1e-7 || math.Abs(longitude-3) > 1e-7 {
+		t.Errorf("(%.10f, %.10f), want (41, 3)", latitude, longitude)
+	}
+
+	if _, _, _, _, err := UTM.ED50.FromLatLon(85, 3, false); err == nil {
+		t.Error("no error for latitude 85")
+	}
+}
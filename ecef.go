@@ -0,0 +1,72 @@
+package UTM
+
+import "math"
+
+// LatLonToECEF convert a latitude, longitude and ellipsoidal height to geocentric, earth-centred earth-fixed coordinates.
+// X points to the Greenwich meridian on the equator, Z to the north pole.
+// Height and coordinates are in metres.
+func LatLonToECEF(latitude, longitude, height float64) (x, y, z float64) {
+	return WGS84.LatLonToECEF(latitude, longitude, height)
+}
+
+// LatLonToECEF is LatLonToECEF on the ellipsoid.
+func (el *Ellipsoid) LatLonToECEF(latitude, longitude, height float64) (x, y, z float64) {
+	sinLat, cosLat := math.Sincos(rad(latitude))
+	sinLon, cosLon := math.Sincos(rad(longitude))
+
+	e2 := el.snyder.e
+	// radius of curvature in the prime vertical
+	n := el.a / math.Sqrt(1-e2*sinLat*sinLat)
+
+	x = (n + height) * cosLat * cosLon
+	y = (n + height) * cosLat * sinLon
+	z = (n*(1-e2) + height) * sinLat
+
+	return
+}
+
+// ECEFToLatLon convert geocentric, earth-centred earth-fixed coordinates to a latitude, longitude and ellipsoidal height.
+// It is the inverse of LatLonToECEF and accurate to well below a millimetre
+// from the centre of the earth to beyond geostationary orbit.
+func ECEFToLatLon(x, y, z float64) (latitude, longitude, height float64) {
+	return WGS84.ECEFToLatLon(x, y, z)
+}
+
+// ECEFToLatLon is ECEFToLatLon on the ellipsoid.
+func (el *Ellipsoid) ECEFToLatLon(x, y, z float64) (latitude, longitude, height float64) {
+	e2 := el.snyder.e
+	b := el.SemiMinorAxis()
+	ep2 := e2 / (1 - e2)
+
+	p := math.Hypot(x, y)
+	longitude = deg(math.Atan2(y, x))
+
+	// Bowring's method, iterated with the parametric latitude. One step is
+	// enough near the surface of the earth.
+	latRad := math.Atan2(z, p*(1-e2))
+	for i := 0; i < 5; i++ {
+		beta := math.Atan2((1-el.Flattening())*math.Sin(latRad), math.Cos(latRad))
+		sinBeta, cosBeta := math.Sincos(beta)
+
+		next := math.Atan2(z+ep2*b*sinBeta*sinBeta*sinBeta, p-e2*el.a*cosBeta*cosBeta*cosBeta)
+		if math.Abs(next-latRad) < 1e-15 {
+			latRad = next
+			break
+		}
+		latRad = next
+	}
+
+	sinLat, cosLat := math.Sincos(latRad)
+	n := el.a / math.Sqrt(1-e2*sinLat*sinLat)
+
+	// Of the two height formulas use the one that is well conditioned.
+	if math.Abs(cosLat) > math.Abs(sinLat) {
+		height = p/cosLat - n
+	} else {
+		height = z/sinLat - n*(1-e2)
+	}
+
+	latitude = deg(latRad)
+
+	return
+}
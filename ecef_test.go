@@ -0,0 +1,44 @@
+package UTM_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestLatLonToECEF(t *testing.T) {
+	t.Parallel()
+
+	// example of EPSG guidance note 7-2, method 9602
+	latitude := 53 + 48/60.0 + 33.82/3600
+	longitude := 2 + 7/60.0 + 46.38/3600
+
+	x, y, z := UTM.LatLonToECEF(latitude, longitude, 73)
+	if math.Abs(x-3771793.968) > 1e-3 || math.Abs(y-140253.342) > 1e-3 || math.Abs(z-5124304.349) > 1e-3 {
+		t.Errorf("(%f, %f, %f), want (3771793.968, 140253.342, 5124304.349)", x, y, z)
+	}
+
+	lat, lon, h := UTM.ECEFToLatLon(x, y, z)
+	if math.Abs(lat-latitude) > 1e-11 || math.Abs(lon-longitude) > 1e-11 || math.Abs(h-73) > 1e-6 {
+		t.Errorf("(%f, %f, %f), want (%f, %f, 73)", lat, lon, h, latitude, longitude)
+	}
+}
+
+func TestECEFRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, el := range []*UTM.Ellipsoid{UTM.WGS84, UTM.Airy1830, UTM.Clarke1866} {
+		for _, latitude := range []float64{-90, -89.999, -45, 0, 0.001, 30, 60, 89.9999, 90} {
+			for _, height := range []float64{-1000, 0, 8848, 35786000} {
+				x, y, z := el.LatLonToECEF(latitude, 123.4, height)
+				lat, lon, h := el.ECEFToLatLon(x, y, z)
+
+				if math.Abs(lat-latitude) > 1e-11 || math.Abs(h-height) > 1e-6 ||
+					math.Abs(math.Cos(latitude*math.Pi/180)*(lon-123.4)) > 1e-11 {
+					t.Errorf("%s (%f, %f): (%.12f, %.12f, %f)", el, latitude, height, lat, lon, h)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package UTM
+
+import (
+	"errors"
+	"math"
+)
+
+// Ellipsoid is a reference ellipsoid defined by its semi-major axis and
+// inverse flattening. All series coefficients used by the conversions are
+// derived from these two parameters when the ellipsoid is created.
+type Ellipsoid struct {
+	name string
+	a    float64
+	invF float64
+
+	snyder   snyder
+	krueger  krueger
+	geodesic geodesic
+}
+
+// Predefined reference ellipsoids.
+var (
+	WGS84             = mustEllipsoid("WGS 84", 6378137, wgs84InverseFlattening)
+	GRS80             = mustEllipsoid("GRS 1980", 6378137, 298.257222101)
+	International1924 = mustEllipsoid("International 1924", 6378388, 297)
+	Clarke1866        = mustEllipsoid("Clarke 1866", 6378206.4, 294.9786982)
+	Bessel1841        = mustEllipsoid("Bessel 1841", 6377397.155, 299.1528128)
+	Krassovsky1940    = mustEllipsoid("Krassowsky 1940", 6378245, 298.3)
+	Airy1830          = mustEllipsoid("Airy 1830", 6377563.396, 299.3249646)
+)
+
+// NewEllipsoid creates an ellipsoid from its semi-major axis in metres and its
+// inverse flattening. The name is only used for display and CRS definitions.
+func NewEllipsoid(name string, semiMajorAxis, inverseFlattening float64) (*Ellipsoid, error) {
+	if !(semiMajorAxis > 0) || math.IsInf(semiMajorAxis, 0) {
+		return nil, errors.New("semi-major axis must be positive")
+	}
+	// Flattening must stay in (0, 1/2) for the eccentricity series to converge.
+	if !(inverseFlattening > 2) || math.IsInf(inverseFlattening, 0) {
+		return nil, errors.New("inverse flattening must be greater than 2")
+	}
+
+	f := 1 / inverseFlattening
+
+	return &Ellipsoid{
+		name:     name,
+		a:        semiMajorAxis,
+		invF:     inverseFlattening,
+		snyder:   newSnyder(semiMajorAxis, f),
+		krueger:  newKrueger(semiMajorAxis, f),
+		geodesic: newGeodesic(semiMajorAxis, f),
+	}, nil
+}
+
+func mustEllipsoid(name string, semiMajorAxis, inverseFlattening float64) *Ellipsoid {
+	el, err := NewEllipsoid(name, semiMajorAxis, inverseFlattening)
+	if err != nil {
+		panic(err)
+	}
+	return el
+}
+
+// Name returns the name of the ellipsoid.
+func (el *Ellipsoid) Name() string { return el.name }
+
+// SemiMajorAxis returns the equatorial radius in metres.
+func (el *Ellipsoid) SemiMajorAxis() float64 { return el.a }
+
+// SemiMinorAxis returns the polar radius in metres.
+func (el *Ellipsoid) SemiMinorAxis() float64 { return el.a * (1 - el.Flattening()) }
+
+// InverseFlattening returns 1/f.
+func (el *Ellipsoid) InverseFlattening() float64 { return el.invF }
+
+// Flattening returns f.
+func (el *Ellipsoid) Flattening() float64 { return 1 / el.invF }
+
+// EccentricitySquared returns the square of the first eccentricity.
+func (el *Ellipsoid) EccentricitySquared() float64 { return el.snyder.e }
+
+func (el *Ellipsoid) String() string { return el.name }
@@ -0,0 +1,136 @@
+package UTM_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func getTestEllipsoids() []*UTM.Ellipsoid {
+	return []*UTM.Ellipsoid{
+		UTM.WGS84,
+		UTM.GRS80,
+		UTM.International1924,
+		UTM.Clarke1866,
+		UTM.Bessel1841,
+		UTM.Krassovsky1940,
+	}
+}
+
+func TestEllipsoidParameters(t *testing.T) {
+	t.Parallel()
+
+	if b := UTM.WGS84.SemiMinorAxis(); math.Abs(b-6356752.314245) > 1e-6 {
+		t.Errorf("WGS84 semi-minor axis = %f", b)
+	}
+
+	if e2 := UTM.WGS84.EccentricitySquared(); math.Abs(e2-0.00669437999014) > 1e-14 {
+		t.Errorf("WGS84 eccentricity squared = %.14f", e2)
+	}
+
+	if b := UTM.Bessel1841.SemiMinorAxis(); math.Abs(b-6356078.963) > 1e-3 {
+		t.Errorf("Bessel 1841 semi-minor axis = %f", b)
+	}
+
+	if f := UTM.International1924.Flattening(); f != 1./297 {
+		t.Errorf("International 1924 flattening = %f", f)
+	}
+}
+
+func TestNewEllipsoidBadInput(t *testing.T) {
+	t.Parallel()
+
+	for i, data := range [][2]float64{
+		{0, 298.257223563},
+		{-6378137, 298.257223563},
+		{math.Inf(1), 298.257223563},
+		{math.NaN(), 298.257223563},
+		{6378137, 0},
+		{6378137, 2},
+		{6378137, math.Inf(1)},
+		{6378137, math.NaN()},
+	} {
+		if _, err := UTM.NewEllipsoid("bad", data[0], data[1]); err == nil {
+			t.Errorf("Expected error. TestNewEllipsoidBadInput case %d", i)
+		}
+	}
+}
+
+func TestEllipsoidMatchesPackageFunctions(t *testing.T) {
+	t.Parallel()
+
+	for i, data := range getTestValues() {
+		e1, n1, z1, l1, err := UTM.FromLatLon(data.LatLon.Latitude, data.LatLon.Longitude, false)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		e2, n2, z2, l2, err := UTM.WGS84.FromLatLon(data.LatLon.Latitude, data.LatLon.Longitude, false)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if e1 != e2 || n1 != n2 || z1 != z2 || l1 != l2 {
+			t.Errorf("WGS84.FromLatLon differs from FromLatLon case %d", i)
+		}
+	}
+}
+
+func TestEllipsoidRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, el := range getTestEllipsoids() {
+		for i, data := range getTestValues() {
+			easting, northing, zoneNumber, zoneLetter, err := el.FromLatLonKrueger(data.LatLon.Latitude, data.LatLon.Longitude, false)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			// The series must agree with each other on every ellipsoid.
+			e2, n2, _, _, err := el.FromLatLon(data.LatLon.Latitude, data.LatLon.Longitude, false)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if d := math.Hypot(easting-e2, northing-n2); d > 0.005 {
+				t.Errorf("%s case %d: series differ by %f m", el, i, d)
+			}
+
+			latitude, longitude, err := el.ToLatLonKrueger(easting, northing, zoneNumber, zoneLetter)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if math.Abs(latitude-data.LatLon.Latitude) > 1e-11 || math.Abs(longitude-data.LatLon.Longitude) > 1e-11 {
+				t.Errorf("%s case %d: round trip (%.12f, %.12f)", el, i, latitude, longitude)
+			}
+
+			latitude, longitude, err = el.ToLatLon(e2, n2, zoneNumber, zoneLetter)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if math.Abs(latitude-data.LatLon.Latitude) > 1e-7 || math.Abs(longitude-data.LatLon.Longitude) > 1e-7 {
+				t.Errorf("%s case %d: round trip (%.9f, %.9f)", el, i, latitude, longitude)
+			}
+		}
+	}
+}
+
+// TestEllipsoidIsUsed guards against conversions silently falling back to
+// WGS84: Clarke 1866 is about 70 m larger, which shows up in the northing.
+func TestEllipsoidIsUsed(t *testing.T) {
+	t.Parallel()
+
+	_, n1, _, _, err := UTM.WGS84.FromLatLon(40.71435, -74.00597, false)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, n2, _, _, err := UTM.Clarke1866.FromLatLon(40.71435, -74.00597, false)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(n1-n2) < 10 {
+		t.Errorf("Clarke 1866 northing %f too close to WGS84 northing %f", n2, n1)
+	}
+}
@@ -0,0 +1,267 @@
+package UTM
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// MarshalText encodes the hemisphere as "north" or "south".
+func (h Hemisphere) MarshalText() ([]byte, error) {
+	if !h.Valid() {
+		return nil, rangeError(ErrHemisphereOutOfRange, FieldHemisphere, float64(h), float64(Northern), float64(Southern))
+	}
+	return []byte(h.String()), nil
+}
+
+// UnmarshalText accepts "north", "south", "N" and "S" in any case.
+func (h *Hemisphere) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "north", "n":
+		*h = Northern
+	case "south", "s":
+		*h = Southern
+	default:
+		return inputError(ErrHemisphereOutOfRange, FieldHemisphere, strconv.Quote(string(text)))
+	}
+	return nil
+}
+
+// pointJSON is the JSON object of a Point.
+type pointJSON struct {
+	Easting    float64    `json:"easting"`
+	Northing   float64    `json:"northing"`
+	ZoneNumber int        `json:"zone_number"`
+	Band       string     `json:"band,omitempty"`
+	Hemisphere Hemisphere `json:"hemisphere,omitempty"`
+}
+
+// MarshalJSON encodes the point as an object such as
+//
+//	{"easting":389000,"northing":5819000,"zone_number":33,"band":"U","hemisphere":"north"}
+//
+// band is left out if it is not known. The point is checked with Validate.
+func (p Point) MarshalJSON() ([]byte, error) {
+	if err := p.validateEncoding(); err != nil {
+		return nil, err
+	}
+
+	v := pointJSON{Easting: p.Easting, Northing: p.Northing, ZoneNumber: p.ZoneNumber, Hemisphere: p.Hemisphere}
+	if p.Band != 0 {
+		v.Band = string(p.Band)
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON decodes the object written by MarshalJSON or a string in a
+// notation of ParsePoint. Without hemisphere it is taken from the band. The
+// point is checked with Validate. null leaves the point unchanged.
+func (p *Point) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return p.UnmarshalText([]byte(s))
+	}
+
+	var v pointJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	q := Point{Easting: v.Easting, Northing: v.Northing, ZoneNumber: v.ZoneNumber, Hemisphere: v.Hemisphere}
+
+	if v.Band != "" {
+		band, ok := mgrsBand(v.Band)
+		if !ok {
+			return letterError(v.Band, 'C', 'X')
+		}
+
+		q.Band = band
+		if q.Hemisphere == 0 {
+			q.Hemisphere = Southern
+			if band >= 'N' {
+				q.Hemisphere = Northern
+			}
+		}
+	}
+
+	if err := q.Validate(); err != nil {
+		return err
+	}
+
+	*p = q
+	return nil
+}
+
+// MarshalText encodes the point as String does. The point is checked with
+// Validate.
+func (p Point) MarshalText() ([]byte, error) {
+	if err := p.validateEncoding(); err != nil {
+		return nil, err
+	}
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText decodes the point with ParsePoint.
+func (p *Point) UnmarshalText(text []byte) error {
+	q, err := ParsePoint(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = q
+	return nil
+}
+
+// Value implements driver.Valuer. The point is stored as text.
+func (p Point) Value() (driver.Value, error) {
+	if err := p.validateEncoding(); err != nil {
+		return nil, err
+	}
+	return p.String(), nil
+}
+
+// Scan implements sql.Scanner for text columns. NULL leaves the zero Point.
+func (p *Point) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*p = Point{}
+		return nil
+	case string:
+		return p.UnmarshalText([]byte(src))
+	case []byte:
+		return p.UnmarshalText(src)
+	default:
+		return fmt.Errorf("cannot scan %T into UTM.Point", src)
+	}
+}
+
+// validateEncoding checks the point with Validate before it is encoded, so
+// that only points ParsePoint reads back are written.
+func (p Point) validateEncoding() error {
+	if err := p.Validate(); err != nil {
+		return inputError(ErrInvalidPoint, FieldNone, err.Error())
+	}
+	return nil
+}
+
+// geodeticJSON is the JSON object of a Geodetic.
+type geodeticJSON struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// MarshalJSON encodes the position as {"latitude":52.5,"longitude":13.4}.
+// The position is checked with Validate.
+func (g Geodetic) MarshalJSON() ([]byte, error) {
+	if err := g.validateEncoding(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(geodeticJSON(g))
+}
+
+// UnmarshalJSON decodes the object written by MarshalJSON or a string as
+// read by UnmarshalText. The position is checked with ValidateLatLone. null
+// leaves the position unchanged.
+func (g *Geodetic) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return g.UnmarshalText([]byte(s))
+	}
+
+	var v geodeticJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if err := ValidateLatLone(v.Latitude, v.Longitude); err != nil {
+		return err
+	}
+
+	*g = Geodetic(v)
+	return nil
+}
+
+// MarshalText encodes the position as String does. The position is checked
+// with Validate.
+func (g Geodetic) MarshalText() ([]byte, error) {
+	if err := g.validateEncoding(); err != nil {
+		return nil, err
+	}
+	return []byte(g.String()), nil
+}
+
+// UnmarshalText decodes latitude and longitude in decimal degrees, separated
+// by a space or comma. The position is checked with ValidateLatLone.
+func (g *Geodetic) UnmarshalText(text []byte) error {
+	fields := strings.FieldsFunc(string(text), func(r rune) bool { return unicode.IsSpace(r) || r == ',' })
+	if len(fields) != 2 {
+		return inputError(ErrInvalidGeodetic, FieldNone, strconv.Quote(string(text)))
+	}
+
+	latitude, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return inputError(ErrInvalidGeodetic, FieldLatitude, strconv.Quote(fields[0]))
+	}
+
+	longitude, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return inputError(ErrInvalidGeodetic, FieldLongitude, strconv.Quote(fields[1]))
+	}
+
+	if err := ValidateLatLone(latitude, longitude); err != nil {
+		return err
+	}
+
+	*g = Geodetic{latitude, longitude}
+	return nil
+}
+
+// Value implements driver.Valuer. The position is stored as text.
+func (g Geodetic) Value() (driver.Value, error) {
+	if err := g.validateEncoding(); err != nil {
+		return nil, err
+	}
+	return g.String(), nil
+}
+
+// Scan implements sql.Scanner for text columns. NULL leaves the zero
+// Geodetic.
+func (g *Geodetic) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*g = Geodetic{}
+		return nil
+	case string:
+		return g.UnmarshalText([]byte(src))
+	case []byte:
+		return g.UnmarshalText(src)
+	default:
+		return fmt.Errorf("cannot scan %T into UTM.Geodetic", src)
+	}
+}
+
+// validateEncoding checks the position with Validate before it is encoded,
+// so that only positions UnmarshalText reads back are written.
+func (g Geodetic) validateEncoding() error {
+	if err := g.Validate(); err != nil {
+		return inputError(ErrInvalidGeodetic, FieldNone, err.Error())
+	}
+	return nil
+}
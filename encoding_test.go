@@ -0,0 +1,293 @@
+package UTM_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+var (
+	_ json.Marshaler           = UTM.Point{}
+	_ json.Unmarshaler         = (*UTM.Point)(nil)
+	_ encoding.TextMarshaler   = UTM.Point{}
+	_ encoding.TextUnmarshaler = (*UTM.Point)(nil)
+	_ driver.Valuer            = UTM.Point{}
+	_ sql.Scanner              = (*UTM.Point)(nil)
+
+	_ json.Marshaler           = UTM.Geodetic{}
+	_ json.Unmarshaler         = (*UTM.Geodetic)(nil)
+	_ encoding.TextMarshaler   = UTM.Geodetic{}
+	_ encoding.TextUnmarshaler = (*UTM.Geodetic)(nil)
+	_ driver.Valuer            = UTM.Geodetic{}
+	_ sql.Scanner              = (*UTM.Geodetic)(nil)
+)
+
+func TestPointJSON(t *testing.T) {
+	t.Parallel()
+
+	points := []UTM.Point{
+		{389000, 5819000, 33, 'U', UTM.Northern},
+		{261878.5, 6243186, 34, 0, UTM.Southern},
+	}
+
+	want := []string{
+		`{"easting":389000,"northing":5819000,"zone_number":33,"band":"U","hemisphere":"north"}`,
+		`{"easting":261878.5,"northing":6243186,"zone_number":34,"hemisphere":"south"}`,
+	}
+
+	for i, p := range points {
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if string(data) != want[i] {
+			t.Errorf("case %d: %s, want %s", i, data, want[i])
+		}
+
+		var q UTM.Point
+		if err := json.Unmarshal(data, &q); err != nil || q != p {
+			t.Errorf("case %d: %+v %v, want %+v", i, q, err, p)
+		}
+	}
+
+	cases := []struct {
+		data string
+		want UTM.Point
+	}{
+		// the hemisphere follows from the band
+		{`{"easting":313784,"northing":5427057,"zone_number":60,"band":"g"}`, UTM.Point{313784, 5427057, 60, 'G', UTM.Southern}},
+		{`{"easting":313784,"northing":5427057,"zone_number":60,"hemisphere":"S"}`, UTM.Point{313784, 5427057, 60, 0, UTM.Southern}},
+		{`"33U 389000 5819000"`, UTM.Point{389000, 5819000, 33, 'U', UTM.Northern}},
+	}
+
+	for i, c := range cases {
+		var p UTM.Point
+		if err := json.Unmarshal([]byte(c.data), &p); err != nil || p != c.want {
+			t.Errorf("case %d: %+v %v, want %+v", i, p, err, c.want)
+		}
+	}
+}
+
+func TestPointUnmarshalErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		data     string
+		sentinel error
+	}{
+		{`{"easting":99999,"northing":5819000,"zone_number":33,"band":"U"}`, UTM.ErrEastingOutOfRange},
+		{`{"easting":389000,"northing":10000001,"zone_number":33,"band":"U"}`, UTM.ErrNorthingOutOfRange},
+		{`{"easting":389000,"northing":5819000,"zone_number":61,"band":"U"}`, UTM.ErrZoneNumberOutOfRange},
+		{`{"easting":389000,"northing":5819000,"zone_number":33,"band":"Y"}`, UTM.ErrZoneLetterOutOfRange},
+		{`{"easting":389000,"northing":5819000,"zone_number":33,"band":"U","hemisphere":"south"}`, UTM.ErrZoneLetterOutOfRange},
+		{`{"easting":389000,"northing":5819000,"zone_number":33,"hemisphere":"up"}`, UTM.ErrHemisphereOutOfRange},
+		{`{"easting":389000,"northing":5819000,"zone_number":33}`, UTM.ErrHemisphereOutOfRange},
+		{`"33U 389000"`, UTM.ErrInvalidPoint},
+	}
+
+	for i, c := range cases {
+		p := UTM.Point{389000, 5819000, 33, 'U', UTM.Northern}
+		before := p
+
+		if err := json.Unmarshal([]byte(c.data), &p); !errors.Is(err, c.sentinel) {
+			t.Errorf("case %d: %v is not %v", i, err, c.sentinel)
+		}
+
+		if p != before {
+			t.Errorf("case %d: point changed to %+v", i, p)
+		}
+	}
+}
+
+func TestJSONNull(t *testing.T) {
+	t.Parallel()
+
+	var v struct {
+		P UTM.Point
+		G UTM.Geodetic
+		H UTM.Hemisphere
+	}
+
+	if err := json.Unmarshal([]byte(`{"P":null,"G":null,"H":null}`), &v); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if v.P != (UTM.Point{}) || v.G != (UTM.Geodetic{}) || v.H != 0 {
+		t.Errorf("%+v, want zero values", v)
+	}
+
+	// null leaves a decoded value alone
+	p := UTM.Point{389000, 5819000, 33, 'U', UTM.Northern}
+	before := p
+	if err := json.Unmarshal([]byte(`null`), &p); err != nil || p != before {
+		t.Errorf("%+v %v, want %+v", p, err, before)
+	}
+}
+
+func TestGeodeticJSON(t *testing.T) {
+	t.Parallel()
+
+	g := UTM.Geodetic{Latitude: 52.5098, Longitude: 13.3644}
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if want := `{"latitude":52.5098,"longitude":13.3644}`; string(data) != want {
+		t.Errorf("%s, want %s", data, want)
+	}
+
+	var h UTM.Geodetic
+	if err := json.Unmarshal(data, &h); err != nil || h != g {
+		t.Errorf("%v %v, want %v", h, err, g)
+	}
+
+	if err := json.Unmarshal([]byte(`"52.5098, 13.3644"`), &h); err != nil || h != g {
+		t.Errorf("%v %v, want %v", h, err, g)
+	}
+
+	cases := []struct {
+		data     string
+		sentinel error
+	}{
+		{`{"latitude":85,"longitude":0}`, UTM.ErrLatitudeOutOfRange},
+		{`{"latitude":0,"longitude":181}`, UTM.ErrLongitudeOutOfRange},
+		{`"-81 0"`, UTM.ErrLatitudeOutOfRange},
+		{`"52.5"`, UTM.ErrInvalidGeodetic},
+		{`"52.5 east"`, UTM.ErrInvalidGeodetic},
+	}
+
+	for i, c := range cases {
+		if err := json.Unmarshal([]byte(c.data), &h); !errors.Is(err, c.sentinel) {
+			t.Errorf("case %d: %v is not %v", i, err, c.sentinel)
+		}
+	}
+}
+
+func TestPointSQL(t *testing.T) {
+	t.Parallel()
+
+	p := UTM.Point{389000.5, 5819000, 33, 'U', UTM.Northern}
+
+	v, err := p.Value()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if v != "33U 389000.5 5819000" {
+		t.Errorf("Value() = %v", v)
+	}
+
+	for _, src := range []interface{}{v, []byte(v.(string))} {
+		var q UTM.Point
+		if err := q.Scan(src); err != nil || q != p {
+			t.Errorf("Scan(%T): %+v %v, want %+v", src, q, err, p)
+		}
+	}
+
+	// NULL scans as the zero point
+	q := p
+	if err := q.Scan(nil); err != nil || q != (UTM.Point{}) {
+		t.Errorf("Scan(nil): %+v %v", q, err)
+	}
+
+	if err := q.Scan("33U 99999 5819000"); !errors.Is(err, UTM.ErrEastingOutOfRange) {
+		t.Errorf("Scan: %v", err)
+	}
+}
+
+func TestPointMarshalInvalid(t *testing.T) {
+	t.Parallel()
+
+	points := []UTM.Point{
+		{},
+		{389000, 5819000, 33, 'U', 0},
+		{99999, 5819000, 33, 'U', UTM.Northern},
+		{389000, 5819000, 33, 'U', UTM.Southern},
+	}
+
+	for i, p := range points {
+		if _, err := p.MarshalText(); !errors.Is(err, UTM.ErrInvalidPoint) {
+			t.Errorf("case %d: MarshalText: %v is not %v", i, err, UTM.ErrInvalidPoint)
+		}
+
+		if _, err := p.Value(); !errors.Is(err, UTM.ErrInvalidPoint) {
+			t.Errorf("case %d: Value: %v is not %v", i, err, UTM.ErrInvalidPoint)
+		}
+
+		if _, err := json.Marshal(p); !errors.Is(err, UTM.ErrInvalidPoint) {
+			t.Errorf("case %d: MarshalJSON: %v is not %v", i, err, UTM.ErrInvalidPoint)
+		}
+	}
+}
+
+func TestGeodeticSQL(t *testing.T) {
+	t.Parallel()
+
+	g := UTM.Geodetic{Latitude: -33.92487, Longitude: 18.42406}
+
+	v, err := g.Value()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if v != "-33.92487 18.42406" {
+		t.Errorf("Value() = %v", v)
+	}
+
+	var h UTM.Geodetic
+	if err := h.Scan([]byte("-33.92487 18.42406")); err != nil || h != g {
+		t.Errorf("Scan: %v %v, want %v", h, err, g)
+	}
+
+	if err := h.Scan(int64(1)); err == nil {
+		t.Error("Scan(int64): no error")
+	}
+
+	// NULL scans as the zero position
+	if err := h.Scan(nil); err != nil || h != (UTM.Geodetic{}) {
+		t.Errorf("Scan(nil): %v %v", h, err)
+	}
+}
+
+func TestGeodeticMarshalInvalid(t *testing.T) {
+	t.Parallel()
+
+	for i, g := range []UTM.Geodetic{{100, 200}, {85, 0}, {0, -181}} {
+		if _, err := g.MarshalText(); !errors.Is(err, UTM.ErrInvalidGeodetic) {
+			t.Errorf("case %d: MarshalText: %v is not %v", i, err, UTM.ErrInvalidGeodetic)
+		}
+
+		if _, err := g.Value(); !errors.Is(err, UTM.ErrInvalidGeodetic) {
+			t.Errorf("case %d: Value: %v is not %v", i, err, UTM.ErrInvalidGeodetic)
+		}
+
+		if _, err := json.Marshal(g); !errors.Is(err, UTM.ErrInvalidGeodetic) {
+			t.Errorf("case %d: MarshalJSON: %v is not %v", i, err, UTM.ErrInvalidGeodetic)
+		}
+	}
+}
+
+func TestHemisphereText(t *testing.T) {
+	t.Parallel()
+
+	var h UTM.Hemisphere
+	if err := h.UnmarshalText([]byte("South")); err != nil || h != UTM.Southern {
+		t.Errorf("UnmarshalText: %v %v", h, err)
+	}
+
+	if text, err := UTM.Northern.MarshalText(); err != nil || string(text) != "north" {
+		t.Errorf("MarshalText: %s %v", text, err)
+	}
+
+	if _, err := UTM.Hemisphere(0).MarshalText(); !errors.Is(err, UTM.ErrHemisphereOutOfRange) {
+		t.Errorf("MarshalText: %v", err)
+	}
+}
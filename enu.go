@@ -0,0 +1,89 @@
+package UTM
+
+import "math"
+
+// ENU is a local east, north, up frame tangent to the ellipsoid at an origin
+// given by latitude, longitude and ellipsoidal height. A nil Ellipsoid stands
+// for WGS84, so the frame agrees with FromLatLon and LatLonToECEF.
+type ENU struct {
+	Latitude  float64 // degrees
+	Longitude float64 // degrees
+	Height    float64 // metres
+	Ellipsoid *Ellipsoid
+}
+
+func (f ENU) ellipsoid() *Ellipsoid {
+	if f.Ellipsoid == nil {
+		return WGS84
+	}
+	return f.Ellipsoid
+}
+
+// FromECEF converts geocentric coordinates to the frame.
+func (f ENU) FromECEF(x, y, z float64) (east, north, up float64) {
+	x0, y0, z0 := f.ellipsoid().LatLonToECEF(f.Latitude, f.Longitude, f.Height)
+	dx, dy, dz := x-x0, y-y0, z-z0
+
+	sinLat, cosLat := math.Sincos(rad(f.Latitude))
+	sinLon, cosLon := math.Sincos(rad(f.Longitude))
+
+	east = -sinLon*dx + cosLon*dy
+	north = -sinLat*cosLon*dx - sinLat*sinLon*dy + cosLat*dz
+	up = cosLat*cosLon*dx + cosLat*sinLon*dy + sinLat*dz
+
+	return
+}
+
+// ToECEF converts coordinates in the frame to geocentric coordinates.
+func (f ENU) ToECEF(east, north, up float64) (x, y, z float64) {
+	x0, y0, z0 := f.ellipsoid().LatLonToECEF(f.Latitude, f.Longitude, f.Height)
+
+	sinLat, cosLat := math.Sincos(rad(f.Latitude))
+	sinLon, cosLon := math.Sincos(rad(f.Longitude))
+
+	// transpose of the rotation in FromECEF
+	x = x0 - sinLon*east - sinLat*cosLon*north + cosLat*cosLon*up
+	y = y0 + cosLon*east - sinLat*sinLon*north + cosLat*sinLon*up
+	z = z0 + cosLat*north + sinLat*up
+
+	return
+}
+
+// FromLatLon converts a latitude, longitude and ellipsoidal height to the frame.
+func (f ENU) FromLatLon(latitude, longitude, height float64) (east, north, up float64) {
+	return f.FromECEF(f.ellipsoid().LatLonToECEF(latitude, longitude, height))
+}
+
+// ToLatLon converts coordinates in the frame to a latitude, longitude and
+// ellipsoidal height.
+func (f ENU) ToLatLon(east, north, up float64) (latitude, longitude, height float64) {
+	return f.ellipsoid().ECEFToLatLon(f.ToECEF(east, north, up))
+}
+
+// FromUTM converts Universal Transverse Mercator coordinates and an
+// ellipsoidal height to the frame. The UTM coordinates are on the ellipsoid
+// of the frame.
+func (f ENU) FromUTM(easting, northing float64, zoneNumber int, hemisphere Hemisphere, height float64) (
+	east, north, up float64, err error,
+) {
+	latitude, longitude, err := f.ellipsoid().ToLatLonHemisphere(easting, northing, zoneNumber, hemisphere)
+	if err != nil {
+		return
+	}
+
+	east, north, up = f.FromLatLon(latitude, longitude, height)
+
+	return
+}
+
+// ToUTM converts coordinates in the frame to Universal Transverse Mercator
+// coordinates in the zone of the point and its ellipsoidal height.
+func (f ENU) ToUTM(east, north, up float64) (
+	easting, northing float64, zoneNumber int, hemisphere Hemisphere, height float64, err error,
+) {
+	latitude, longitude, height := f.ToLatLon(east, north, up)
+
+	easting, northing, zoneNumber, hemisphere, err = f.ellipsoid().FromLatLonHemisphere(latitude, longitude)
+
+	return
+}
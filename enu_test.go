@@ -0,0 +1,73 @@
+package UTM_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestENU(t *testing.T) {
+	t.Parallel()
+
+	frame := UTM.ENU{Latitude: 52.5, Longitude: 13.4, Height: 40}
+
+	if e, n, u := frame.FromLatLon(52.5, 13.4, 40); math.Abs(e) > 1e-9 || math.Abs(n) > 1e-9 || math.Abs(u) > 1e-9 {
+		t.Errorf("origin: (%g, %g, %g)", e, n, u)
+	}
+
+	// a point straight above the origin
+	if e, n, u := frame.FromLatLon(52.5, 13.4, 140); math.Abs(e) > 1e-9 || math.Abs(n) > 1e-9 || math.Abs(u-100) > 1e-9 {
+		t.Errorf("above: (%g, %g, %g), want (0, 0, 100)", e, n, u)
+	}
+
+	// east of the origin on the same parallel, north on the same meridian
+	if e, n, _ := frame.FromLatLon(52.5, 13.41, 40); !(e > 670 && e < 690 && math.Abs(n) < 0.1) {
+		t.Errorf("east: (%f, %f)", e, n)
+	}
+	if e, n, _ := frame.FromLatLon(52.51, 13.4, 40); !(n > 1100 && n < 1120 && math.Abs(e) < 1e-9) {
+		t.Errorf("north: (%f, %f)", e, n)
+	}
+
+	latitude, longitude, height := frame.ToLatLon(1234.5, -678.9, 12.3)
+	e, n, u := frame.FromLatLon(latitude, longitude, height)
+	if math.Abs(e-1234.5) > 1e-6 || math.Abs(n+678.9) > 1e-6 || math.Abs(u-12.3) > 1e-6 {
+		t.Errorf("round trip: (%f, %f, %f)", e, n, u)
+	}
+}
+
+func TestENUUTM(t *testing.T) {
+	t.Parallel()
+
+	frame := UTM.ENU{Latitude: -33.9, Longitude: 18.4, Ellipsoid: UTM.WGS84}
+
+	easting, northing, zoneNumber, hemisphere, err := UTM.FromLatLonHemisphere(-33.91, 18.42)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	e, n, u, err := frame.FromUTM(easting, northing, zoneNumber, hemisphere, 25)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	wantE, wantN, wantU := frame.FromLatLon(-33.91, 18.42, 25)
+	if math.Abs(e-wantE) > 1e-3 || math.Abs(n-wantN) > 1e-3 || math.Abs(u-wantU) > 1e-3 {
+		t.Errorf("(%f, %f, %f), want (%f, %f, %f)", e, n, u, wantE, wantN, wantU)
+	}
+
+	gotEasting, gotNorthing, gotZone, gotHemisphere, height, err := frame.ToUTM(e, n, u)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(gotEasting-easting) > 1e-3 || math.Abs(gotNorthing-northing) > 1e-3 ||
+		gotZone != zoneNumber || gotHemisphere != hemisphere || math.Abs(height-25) > 1e-6 {
+		t.Errorf("(%f, %f, %d, %s, %f), want (%f, %f, %d, %s, 25)",
+			gotEasting, gotNorthing, gotZone, gotHemisphere, height, easting, northing, zoneNumber, hemisphere)
+	}
+
+	if _, _, _, err := frame.FromUTM(easting, northing, 61, hemisphere, 0); err == nil {
+		t.Error("no error for zone 61")
+	}
+}
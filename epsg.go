@@ -0,0 +1,101 @@
+package UTM
+
+import (
+	"strconv"
+	"strings"
+)
+
+// EPSG code ranges of the UTM zones. ETRS89 only defines the northern zones
+// 28 to 38.
+const (
+	epsgWGS84North  = 32600
+	epsgWGS84South  = 32700
+	epsgETRS89North = 25800
+
+	etrs89MinZone = 28
+	etrs89MaxZone = 38
+)
+
+// EPSG returns the EPSG code of a WGS84 UTM zone, 326xx in the north and 327xx
+// in the south.
+func EPSG(zoneNumber int, hemisphere Hemisphere) (int, error) {
+	return WGS84.EPSG(zoneNumber, hemisphere)
+}
+
+// EPSG returns the EPSG code of a UTM zone on the ellipsoid. WGS84 zones are
+// 326xx and 327xx, GRS80 zones are the ETRS89 codes 25828 to 25838. Other
+// ellipsoids and zones have no code and return an error wrapping
+// ErrUnsupportedCRS.
+func (el *Ellipsoid) EPSG(zoneNumber int, hemisphere Hemisphere) (int, error) {
+	return CRS{zoneNumber, hemisphere, el}.EPSG()
+}
+
+// EPSG returns the EPSG code of the CRS, see Ellipsoid.EPSG.
+func (c CRS) EPSG() (int, error) {
+	if err := c.Validate(); err != nil {
+		return 0, err
+	}
+
+	el := c.ellipsoid()
+
+	switch {
+	case el.sameAs(WGS84) && c.Hemisphere == Northern:
+		return epsgWGS84North + c.ZoneNumber, nil
+	case el.sameAs(WGS84):
+		return epsgWGS84South + c.ZoneNumber, nil
+	case el.sameAs(GRS80) && c.Hemisphere == Northern &&
+		etrs89MinZone <= c.ZoneNumber && c.ZoneNumber <= etrs89MaxZone:
+		return epsgETRS89North + c.ZoneNumber, nil
+	}
+
+	return 0, inputError(ErrUnsupportedCRS, FieldNone, "no EPSG code for "+c.String())
+}
+
+// EPSGToCRS returns the UTM zone, hemisphere and ellipsoid of an EPSG code.
+// Codes of other coordinate reference systems return an error wrapping
+// ErrUnsupportedCRS.
+func EPSGToCRS(code int) (CRS, error) {
+	zoneNumber := code % 100
+
+	switch code - zoneNumber {
+	case epsgWGS84North:
+		if 1 <= zoneNumber && zoneNumber <= 60 {
+			return CRS{zoneNumber, Northern, WGS84}, nil
+		}
+	case epsgWGS84South:
+		if 1 <= zoneNumber && zoneNumber <= 60 {
+			return CRS{zoneNumber, Southern, WGS84}, nil
+		}
+	case epsgETRS89North:
+		if etrs89MinZone <= zoneNumber && zoneNumber <= etrs89MaxZone {
+			return CRS{zoneNumber, Northern, GRS80}, nil
+		}
+	}
+
+	return CRS{}, inputError(ErrUnsupportedCRS, FieldNone, "EPSG:"+strconv.Itoa(code)+" is not a UTM zone")
+}
+
+// ParseEPSG parses an EPSG code such as "EPSG:32633",
+// "urn:ogc:def:crs:EPSG::32633", "http://www.opengis.net/def/crs/EPSG/0/32633"
+// or "32633" and returns its CRS as EPSGToCRS does.
+func ParseEPSG(s string) (CRS, error) {
+	code := strings.TrimSpace(s)
+	lower := strings.ToLower(code)
+
+	switch {
+	case strings.HasPrefix(lower, "epsg:"):
+		code = code[len("epsg:"):]
+	case strings.HasPrefix(lower, "urn:ogc:def:crs:epsg:"):
+		// the version between the colons is usually empty
+		code = code[strings.LastIndexByte(code, ':')+1:]
+	case strings.HasPrefix(lower, "http://www.opengis.net/def/crs/epsg/"):
+		code = code[strings.LastIndexByte(code, '/')+1:]
+	}
+
+	n, err := strconv.Atoi(code)
+	if err != nil || n <= 0 {
+		return CRS{}, inputError(ErrInvalidCRS, FieldNone, strconv.Quote(s))
+	}
+
+	return EPSGToCRS(n)
+}
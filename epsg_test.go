@@ -0,0 +1,124 @@
+package UTM_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestEPSG(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		el         *UTM.Ellipsoid
+		zoneNumber int
+		hemisphere UTM.Hemisphere
+		code       int
+	}{
+		{UTM.WGS84, 33, UTM.Northern, 32633},
+		{UTM.WGS84, 1, UTM.Southern, 32701},
+		{UTM.WGS84, 60, UTM.Southern, 32760},
+		{UTM.GRS80, 28, UTM.Northern, 25828},
+		{UTM.GRS80, 32, UTM.Northern, 25832},
+		{UTM.GRS80, 38, UTM.Northern, 25838},
+	}
+
+	for i, c := range cases {
+		code, err := c.el.EPSG(c.zoneNumber, c.hemisphere)
+		if err != nil || code != c.code {
+			t.Errorf("case %d: %d %v, want %d", i, code, err, c.code)
+		}
+
+		crs, err := UTM.EPSGToCRS(c.code)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if crs.ZoneNumber != c.zoneNumber || crs.Hemisphere != c.hemisphere || crs.Ellipsoid != c.el {
+			t.Errorf("case %d: %v", i, crs)
+		}
+	}
+
+	if code, err := UTM.EPSG(18, UTM.Northern); err != nil || code != 32618 {
+		t.Errorf("EPSG: %d %v", code, err)
+	}
+
+	errorCases := []struct {
+		el         *UTM.Ellipsoid
+		zoneNumber int
+		hemisphere UTM.Hemisphere
+		sentinel   error
+	}{
+		{UTM.WGS84, 61, UTM.Northern, UTM.ErrZoneNumberOutOfRange},
+		{UTM.WGS84, 33, 0, UTM.ErrHemisphereOutOfRange},
+		{UTM.GRS80, 27, UTM.Northern, UTM.ErrUnsupportedCRS},
+		{UTM.GRS80, 32, UTM.Southern, UTM.ErrUnsupportedCRS},
+		{UTM.Bessel1841, 33, UTM.Northern, UTM.ErrUnsupportedCRS},
+	}
+
+	for i, c := range errorCases {
+		if _, err := c.el.EPSG(c.zoneNumber, c.hemisphere); !errors.Is(err, c.sentinel) {
+			t.Errorf("case %d: %v is not %v", i, err, c.sentinel)
+		}
+	}
+}
+
+func TestParseEPSG(t *testing.T) {
+	t.Parallel()
+
+	want := UTM.CRS{ZoneNumber: 33, Hemisphere: UTM.Northern, Ellipsoid: UTM.WGS84}
+
+	for _, s := range []string{
+		"EPSG:32633",
+		"epsg:32633",
+		"urn:ogc:def:crs:EPSG::32633",
+		"urn:ogc:def:crs:EPSG:9.9.1:32633",
+		"http://www.opengis.net/def/crs/EPSG/0/32633",
+		" 32633 ",
+	} {
+		crs, err := UTM.ParseEPSG(s)
+		if err != nil || crs != want {
+			t.Errorf("%q: %v %v", s, crs, err)
+		}
+	}
+
+	errorCases := []struct {
+		s        string
+		sentinel error
+	}{
+		{"EPSG:4326", UTM.ErrUnsupportedCRS},
+		{"32600", UTM.ErrUnsupportedCRS},
+		{"32661", UTM.ErrUnsupportedCRS},
+		{"25827", UTM.ErrUnsupportedCRS},
+		{"25839", UTM.ErrUnsupportedCRS},
+		{"", UTM.ErrInvalidCRS},
+		{"EPSG:", UTM.ErrInvalidCRS},
+		{"ESRI:32633", UTM.ErrInvalidCRS},
+		{"-32633", UTM.ErrInvalidCRS},
+	}
+
+	for i, c := range errorCases {
+		if _, err := UTM.ParseEPSG(c.s); !errors.Is(err, c.sentinel) {
+			t.Errorf("case %d: %v is not %v", i, err, c.sentinel)
+		}
+	}
+}
+
+func TestParseEPSGToLatLon(t *testing.T) {
+	t.Parallel()
+
+	crs, err := UTM.ParseEPSG("EPSG:32630")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	latitude, longitude, err := UTM.ToLatLon(377486, 6296562, crs.ZoneNumber, "", crs.Hemisphere == UTM.Northern)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if round(latitude*1e5) != 56.79680*1e5 || round(longitude*1e5) != -5.00601*1e5 {
+		t.Errorf("(%f, %f)", latitude, longitude)
+	}
+}
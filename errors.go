@@ -0,0 +1,134 @@
+package UTM
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Sentinel errors wrapped by InputError. Use errors.Is to tell them apart.
+var (
+	ErrEastingOutOfRange    = errors.New("easting out of range")
+	ErrNorthingOutOfRange   = errors.New("northing out of range")
+	ErrZoneNumberOutOfRange = errors.New("zone number out of range")
+	ErrZoneLetterOutOfRange = errors.New("zone letter out of range")
+	ErrLatitudeOutOfRange   = errors.New("latitude out of range")
+	ErrLongitudeOutOfRange  = errors.New("longitude out of range")
+	ErrPrecisionOutOfRange  = errors.New("precision out of range")
+	ErrHemisphereOutOfRange = errors.New("hemisphere out of range")
+
+	// ErrHemisphereMissing means neither a zone letter nor northern was set.
+	ErrHemisphereMissing = errors.New("either ZoneLetter or northern needs to be set")
+	// ErrHemisphereAmbiguous means both a zone letter and northern were set.
+	ErrHemisphereAmbiguous = errors.New("set either ZoneLetter or northern, but not both")
+
+	ErrInvalidMGRS       = errors.New("invalid MGRS reference")
+	ErrInvalidPoint      = errors.New("invalid UTM coordinate")
+	ErrInvalidGeodetic   = errors.New("invalid latitude and longitude")
+	ErrInvalidCRS        = errors.New("invalid coordinate reference system")
+	ErrUnsupportedCRS    = errors.New("unsupported coordinate reference system")
+	ErrInvalidGridZone   = errors.New("invalid grid zone designator")
+	ErrInvalidProjection = errors.New("invalid projection parameters")
+	ErrLengthMismatch    = errors.New("slices must have the same length")
+
+	// ErrLatitudeOutsideBand and ErrLongitudeOutsideZone mean coordinates
+	// don't agree with their grid zone, see ToLatLonStrict.
+	ErrLatitudeOutsideBand  = errors.New("latitude outside latitude band")
+	ErrLongitudeOutsideZone = errors.New("longitude outside zone")
+)
+
+// Field identifies the input an InputError refers to.
+type Field int
+
+// Fields of InputError.
+const (
+	FieldNone Field = iota
+	FieldEasting
+	FieldNorthing
+	FieldZoneNumber
+	FieldZoneLetter
+	FieldLatitude
+	FieldLongitude
+	FieldPrecision
+	FieldHemisphere
+)
+
+var fieldNames = [...]string{
+	FieldNone:       "none",
+	FieldEasting:    "easting",
+	FieldNorthing:   "northing",
+	FieldZoneNumber: "zone number",
+	FieldZoneLetter: "zone letter",
+	FieldLatitude:   "latitude",
+	FieldLongitude:  "longitude",
+	FieldPrecision:  "precision",
+	FieldHemisphere: "hemisphere",
+}
+
+func (f Field) String() string {
+	if 0 <= f && int(f) < len(fieldNames) {
+		return fieldNames[f]
+	}
+	return "Field(" + strconv.Itoa(int(f)) + ")"
+}
+
+// InputError allow to distinguish if an error is from UTM conversion functions.
+// It records which input failed, the offending value and the allowed range
+// [Min, Max]. For FieldZoneLetter the values are character codes. Err is one
+// of the sentinel errors above and is returned by Unwrap, so
+//
+//	var inputErr UTM.InputError
+//	if errors.As(err, &inputErr) && inputErr.Field == UTM.FieldEasting { ... }
+//	if errors.Is(err, UTM.ErrEastingOutOfRange) { ... }
+//
+// both work.
+type InputError struct {
+	Field    Field
+	Value    float64
+	Min, Max float64
+	Err      error
+
+	detail string
+}
+
+func (e InputError) Error() string {
+	switch {
+	case e.detail != "":
+		return fmt.Sprintf("%s (%s)", e.Err, e.detail)
+	case e.Field == FieldZoneLetter:
+		return fmt.Sprintf("%s: %q (must be between %c and %c)", e.Err, rune(e.Value), rune(e.Min), rune(e.Max))
+	case e.Field != FieldNone:
+		return fmt.Sprintf("%s: %s (must be between %s and %s)", e.Err,
+			formatFloat(e.Value), formatFloat(e.Min), formatFloat(e.Max))
+	default:
+		return e.Err.Error()
+	}
+}
+
+// Unwrap returns the sentinel error.
+func (e InputError) Unwrap() error {
+	return e.Err
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// rangeError reports a value outside [min, max].
+func rangeError(err error, field Field, value, min, max float64) InputError {
+	return InputError{Field: field, Value: value, Min: min, Max: max, Err: err}
+}
+
+// letterError reports a zone letter outside [min, max].
+func letterError(zoneLetter string, min, max rune) InputError {
+	var value rune
+	if zoneLetter != "" {
+		value = []rune(zoneLetter)[0]
+	}
+	return rangeError(ErrZoneLetterOutOfRange, FieldZoneLetter, float64(value), float64(min), float64(max))
+}
+
+// inputError reports err with a free form explanation.
+func inputError(err error, field Field, detail string) InputError {
+	return InputError{Field: field, Err: err, detail: detail}
+}
@@ -0,0 +1,129 @@
+package UTM_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestToLatLonErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		testCoordinate
+		northern []bool
+		sentinel error
+		field    UTM.Field
+		value    float64
+	}{
+		{testCoordinate{377486, 6296562, 30, ""}, nil, UTM.ErrHemisphereMissing, UTM.FieldNone, 0},
+		{testCoordinate{377486, 6296562, 30, "V"}, []bool{true}, UTM.ErrHemisphereAmbiguous, UTM.FieldNone, 0},
+		{testCoordinate{99999, 6296562, 30, "V"}, nil, UTM.ErrEastingOutOfRange, UTM.FieldEasting, 99999},
+		{testCoordinate{377486, 10000001, 30, "V"}, nil, UTM.ErrNorthingOutOfRange, UTM.FieldNorthing, 10000001},
+		{testCoordinate{377486, 6296562, 61, "V"}, nil, UTM.ErrZoneNumberOutOfRange, UTM.FieldZoneNumber, 61},
+		{testCoordinate{377486, 6296562, 30, "Y"}, nil, UTM.ErrZoneLetterOutOfRange, UTM.FieldZoneLetter, 'Y'},
+	}
+
+	for i, c := range cases {
+		_, _, err := UTM.ToLatLon(c.Easting, c.Northing, c.ZoneNumber, c.ZoneLetter, c.northern...)
+
+		if !errors.Is(err, c.sentinel) {
+			t.Errorf("case %d: %v is not %v", i, err, c.sentinel)
+		}
+
+		var inputErr UTM.InputError
+		if !errors.As(err, &inputErr) {
+			t.Fatalf("case %d: %v is not an InputError", i, err)
+		}
+
+		if inputErr.Field != c.field || inputErr.Value != c.value {
+			t.Errorf("case %d: field %s value %f, want %s %f", i, inputErr.Field, inputErr.Value, c.field, c.value)
+		}
+	}
+}
+
+func TestFromLatLonErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		testLatLon
+		sentinel error
+		field    UTM.Field
+		min, max float64
+	}{
+		{testLatLon{-81, 0}, UTM.ErrLatitudeOutOfRange, UTM.FieldLatitude, -80, 84},
+		{testLatLon{85, 0}, UTM.ErrLatitudeOutOfRange, UTM.FieldLatitude, -80, 84},
+		{testLatLon{0, -185}, UTM.ErrLongitudeOutOfRange, UTM.FieldLongitude, -180, 180},
+		{testLatLon{0, 185}, UTM.ErrLongitudeOutOfRange, UTM.FieldLongitude, -180, 180},
+	}
+
+	for i, c := range cases {
+		_, _, _, _, err := UTM.FromLatLon(c.Latitude, c.Longitude, false)
+
+		if !errors.Is(err, c.sentinel) {
+			t.Errorf("case %d: %v is not %v", i, err, c.sentinel)
+		}
+
+		if !errors.Is(UTM.ValidateLatLone(c.Latitude, c.Longitude), c.sentinel) {
+			t.Errorf("case %d: ValidateLatLone does not return %v", i, c.sentinel)
+		}
+
+		var inputErr UTM.InputError
+		if !errors.As(err, &inputErr) {
+			t.Fatalf("case %d: %v is not an InputError", i, err)
+		}
+
+		if inputErr.Field != c.field || inputErr.Min != c.min || inputErr.Max != c.max {
+			t.Errorf("case %d: field %s range [%f, %f]", i, inputErr.Field, inputErr.Min, inputErr.Max)
+		}
+	}
+}
+
+func TestInputErrorMessage(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		err  error
+		want string
+	}{
+		{
+			func() error { _, _, err := UTM.ToLatLon(99999, 6296562, 30, "V"); return err }(),
+			"easting out of range: 99999 (must be between 100000 and 1000000)",
+		},
+		{
+			func() error { _, _, err := UTM.ToLatLon(377486, 6296562, 30, "y"); return err }(),
+			`zone letter out of range: 'y' (must be between C and X)`,
+		},
+		{
+			func() error { _, _, err := UTM.ToLatLon(377486, 6296562, 30, ""); return err }(),
+			"either ZoneLetter or northern needs to be set",
+		},
+		{
+			UTM.ValidateLatLone(-80.5, 0),
+			"latitude out of range: -80.5 (must be between -80 and 84)",
+		},
+		{
+			func() error { _, _, _, _, err := UTM.MGRSToUTM("15TAG"); return err }(),
+			`invalid MGRS reference (100 km square "AG" in zone 15)`,
+		},
+	}
+
+	for i, c := range cases {
+		if c.err == nil || c.err.Error() != c.want {
+			t.Errorf("case %d: %v, want %s", i, c.err, c.want)
+		}
+	}
+}
+
+func TestFieldString(t *testing.T) {
+	t.Parallel()
+
+	if s := UTM.FieldZoneNumber.String(); s != "zone number" {
+		t.Errorf("FieldZoneNumber.String() = %q", s)
+	}
+
+	if s := UTM.Field(100).String(); s != "Field(100)" {
+		t.Errorf("Field(100).String() = %q", s)
+	}
+}
@@ -0,0 +1,78 @@
+package UTM
+
+import "math"
+
+// DefaultZoneOffset is the default limit, in degrees of longitude, on how far
+// outside its nominal 6 degree zone FromLatLonZone projects a point. It
+// covers the whole neighbouring zone on either side, up to 9 degrees from the
+// central meridian, as well as the widened Norway and Svalbard zones.
+const DefaultZoneOffset = 6.0
+
+// FromLatLonZone convert a latitude and longitude to Universal Transverse Mercator coordinates
+// in the given zone instead of the zone the point falls into. It is the
+// counterpart of force_zone_number and force_zone_letter in the Python
+// version and allows a project area straddling a zone boundary to be
+// expressed in a single zone.
+// As in ToLatLon the hemisphere, which decides the false northing, is given
+// either by the zone letter or by the "northern" parameter, but not both.
+// maxOffset limits how many degrees of longitude outside the nominal zone
+// (central meridian ± 3 degrees) the point may lie, DefaultZoneOffset is a
+// sensible choice. The conversion uses the Krüger series, which stays
+// accurate well outside the zone.
+func FromLatLonZone(
+	latitude, longitude float64,
+	zoneNumber int,
+	zoneLetter string,
+	maxOffset float64,
+	northern ...bool) (
+	easting, northing float64, err error,
+) {
+	return WGS84.FromLatLonZone(latitude, longitude, zoneNumber, zoneLetter, maxOffset, northern...)
+}
+
+// FromLatLonZone is FromLatLonZone on the ellipsoid.
+func (el *Ellipsoid) FromLatLonZone(
+	latitude, longitude float64,
+	zoneNumber int,
+	zoneLetter string,
+	maxOffset float64,
+	northern ...bool) (
+	easting, northing float64, err error,
+) {
+	northernValue, err := parseHemisphere(zoneLetter, northern...)
+	if err != nil {
+		return
+	}
+
+	return el.fromLatLonZone(latitude, longitude, zoneNumber, northernValue, maxOffset)
+}
+
+func (el *Ellipsoid) fromLatLonZone(
+	latitude, longitude float64,
+	zoneNumber int,
+	northern bool,
+	maxOffset float64) (
+	easting, northing float64, err error,
+) {
+	err = ValidateLatLone(latitude, longitude)
+	if err != nil {
+		return
+	}
+
+	if !(1 <= zoneNumber && zoneNumber <= 60) {
+		err = rangeError(ErrZoneNumberOutOfRange, FieldZoneNumber, float64(zoneNumber), 1, 60)
+		return
+	}
+
+	centralLon := float64(zoneNumberToCentralLongitude(zoneNumber))
+
+	dLon := normalizeLongitude(longitude - centralLon)
+	if !(math.Abs(dLon) <= 3+maxOffset) {
+		err = rangeError(ErrLongitudeOutOfRange, FieldLongitude, longitude, centralLon-3-maxOffset, centralLon+3+maxOffset)
+		return
+	}
+
+	easting, northing = el.utm(zoneNumber, northern).forward(rad(latitude), rad(dLon))
+
+	return
+}
@@ -0,0 +1,149 @@
+package UTM_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestFromLatLonZoneNaturalZone(t *testing.T) {
+	t.Parallel()
+
+	for i, data := range getTestValues() {
+		easting, northing, zoneNumber, zoneLetter, err := UTM.FromLatLonKrueger(data.LatLon.Latitude, data.LatLon.Longitude, false)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		e, n, err := UTM.FromLatLonZone(data.LatLon.Latitude, data.LatLon.Longitude, zoneNumber, zoneLetter, UTM.DefaultZoneOffset)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(e-easting) > 1e-6 || math.Abs(n-northing) > 1e-6 {
+			t.Errorf("FromLatLonZone differs from FromLatLonKrueger case %d", i)
+		}
+	}
+}
+
+func TestFromLatLonZoneNeighbour(t *testing.T) {
+	t.Parallel()
+
+	// Aachen lies in zone 32, force it into zone 31 and back.
+	latitude, longitude := 50.77535, 6.08389
+
+	easting, northing, err := UTM.FromLatLonZone(latitude, longitude, 31, "U", UTM.DefaultZoneOffset)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if easting <= 500000 {
+		t.Errorf("easting %f must lie east of the zone 31 central meridian", easting)
+	}
+
+	lat, lon, err := UTM.ToLatLonKrueger(easting, northing, 31, "U")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(lat-latitude) > 1e-11 || math.Abs(lon-longitude) > 1e-11 {
+		t.Errorf("round trip (%.12f, %.12f)", lat, lon)
+	}
+
+	// Zone 60 and zone 1 are neighbours across the antimeridian.
+	easting, _, err = UTM.FromLatLonZone(0, 179, 1, "", UTM.DefaultZoneOffset, true)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if easting >= 500000 {
+		t.Errorf("easting %f must lie west of the zone 1 central meridian", easting)
+	}
+}
+
+func TestDefaultZoneOffset(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		latitude, longitude float64
+		zoneNumber          int
+	}{
+		// the far edges of both neighbours of zone 31
+		{50, -6, 31},
+		{50, 12, 31},
+		// the edges of 32V and the Svalbard zones in their own zone
+		{60, 3, 32},
+		{75, 9, 31},
+		{75, 9, 33},
+		{75, 21, 33},
+		{75, 21, 35},
+		{75, 33, 35},
+		{75, 33, 37},
+		{75, 42, 37},
+	}
+
+	for _, c := range cases {
+		if _, _, err := UTM.FromLatLonZone(c.latitude, c.longitude, c.zoneNumber, "", UTM.DefaultZoneOffset, true); err != nil {
+			t.Errorf("(%f, %f) in zone %d: %s", c.latitude, c.longitude, c.zoneNumber, err)
+		}
+	}
+
+	// beyond the neighbouring zone
+	if _, _, err := UTM.FromLatLonZone(50, 12.5, 31, "", UTM.DefaultZoneOffset, true); !errors.Is(err, UTM.ErrLongitudeOutOfRange) {
+		t.Errorf("%v is not %v", err, UTM.ErrLongitudeOutOfRange)
+	}
+}
+
+func TestFromLatLonZoneHemisphere(t *testing.T) {
+	t.Parallel()
+
+	_, north, err := UTM.FromLatLonZone(1, 3, 31, "", UTM.DefaultZoneOffset, true)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, south, err := UTM.FromLatLonZone(1, 3, 31, "M", UTM.DefaultZoneOffset)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(south-north-10000000) > 1e-6 {
+		t.Errorf("forced southern northing %f, northern %f", south, north)
+	}
+}
+
+func TestFromLatLonZoneBadInput(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		latitude, longitude float64
+		zoneNumber          int
+		zoneLetter          string
+		maxOffset           float64
+		northern            []bool
+	}{
+		{50, 6, 31, "", UTM.DefaultZoneOffset, nil},
+		{50, 6, 31, "U", UTM.DefaultZoneOffset, []bool{true}},
+		{50, 6, 31, "Y", UTM.DefaultZoneOffset, nil},
+		{85, 6, 31, "U", UTM.DefaultZoneOffset, nil},
+		{50, 6, 0, "U", UTM.DefaultZoneOffset, nil},
+		{50, 6, 61, "U", UTM.DefaultZoneOffset, nil},
+		// two zones away
+		{50, 6, 29, "U", UTM.DefaultZoneOffset, nil},
+		// just outside the nominal zone without any allowance
+		{50, 6.5, 31, "U", 0, nil},
+		{50, 6, 31, "U", math.NaN(), nil},
+	}
+
+	for i, c := range cases {
+		_, _, err := UTM.FromLatLonZone(c.latitude, c.longitude, c.zoneNumber, c.zoneLetter, c.maxOffset, c.northern...)
+		if err == nil {
+			t.Errorf("Expected error. TestFromLatLonZoneBadInput case %d", i)
+		}
+		if _, ok := err.(UTM.InputError); !ok {
+			t.Error("Type of error must be UTM.InputError.")
+		}
+	}
+}
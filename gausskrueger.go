@@ -0,0 +1,138 @@
+package UTM
+
+import (
+	"math"
+	"strconv"
+)
+
+// GaussKrueger is a Gauss–Krüger zone system: transverse Mercator zones
+// ZoneWidth degrees wide with scale factor 1 on the central meridian. The
+// zone number is prefixed to the easting, so zone 4 eastings read
+// 4 468 123.45 with the false easting of 500000 m after the prefix.
+//
+// Zones are counted eastwards from the Greenwich meridian. 6 degree zones
+// are numbered 1 to 60, zone n spans 6n-6 to 6n degrees east. 3 degree zones
+// are numbered 0 to 119, zone n is centred on 3n degrees east, so zone 0 is
+// centred on Greenwich and its eastings have no prefix. Longitudes west of
+// Greenwich continue from 360 degrees.
+type GaussKrueger struct {
+	ZoneWidth int // 3 or 6
+	Ellipsoid *Ellipsoid
+}
+
+// The usual Gauss–Krüger systems: 3 degree zones on the Bessel ellipsoid as
+// used in Germany (DHDN) and 6 degree zones on the Krassovsky ellipsoid as
+// used with Pulkovo 1942.
+var (
+	GaussKrueger3 = GaussKrueger{ZoneWidth: 3, Ellipsoid: Bessel1841}
+	GaussKrueger6 = GaussKrueger{ZoneWidth: 6, Ellipsoid: Krassovsky1940}
+)
+
+// zoneRange returns the first and the last zone number.
+func (gk GaussKrueger) zoneRange() (first, last int) {
+	if gk.ZoneWidth == 3 {
+		return 0, 119
+	}
+	return 1, 360 / gk.ZoneWidth
+}
+
+// Validate checks the zone width.
+func (gk GaussKrueger) Validate() error {
+	if gk.ZoneWidth != 3 && gk.ZoneWidth != 6 {
+		return inputError(ErrInvalidProjection, FieldNone, "Gauss–Krüger zones are 3 or 6 degrees wide, not "+strconv.Itoa(gk.ZoneWidth))
+	}
+	return nil
+}
+
+// Zone returns the projection of a zone, with the zone prefix included in
+// the false easting.
+func (gk GaussKrueger) Zone(zoneNumber int) (TransverseMercator, error) {
+	if err := gk.Validate(); err != nil {
+		return TransverseMercator{}, err
+	}
+
+	first, last := gk.zoneRange()
+	if !(first <= zoneNumber && zoneNumber <= last) {
+		return TransverseMercator{}, rangeError(ErrZoneNumberOutOfRange, FieldZoneNumber, float64(zoneNumber), float64(first), float64(last))
+	}
+
+	centralMeridian := gk.ZoneWidth * zoneNumber
+	if gk.ZoneWidth == 6 {
+		centralMeridian -= 3
+	}
+
+	return TransverseMercator{
+		CentralMeridian: normalizeLongitude(float64(centralMeridian)),
+		ScaleFactor:     1,
+		FalseEasting:    float64(zoneNumber)*1000000 + 500000,
+		Ellipsoid:       gk.Ellipsoid,
+	}, nil
+}
+
+// ZoneNumber returns the zone a longitude falls into.
+func (gk GaussKrueger) ZoneNumber(longitude float64) (int, error) {
+	if err := gk.Validate(); err != nil {
+		return 0, err
+	}
+
+	if !(-180 <= longitude && longitude <= 180) {
+		return 0, rangeError(ErrLongitudeOutOfRange, FieldLongitude, longitude, -180, 180)
+	}
+
+	east := math.Mod(longitude+360, 360)
+
+	if gk.ZoneWidth == 6 {
+		// east is below 360 unless a tiny negative longitude rounds up
+		return int(east/6)%60 + 1, nil
+	}
+
+	// the zone centred on Greenwich is zone 0 on both sides
+	return int((east+1.5)/3) % 120, nil
+}
+
+// FromLatLon convert a latitude and longitude to Gauss–Krüger coordinates in the zone the point falls into.
+// The easting carries the zone number as prefix, which is returned as well.
+func (gk GaussKrueger) FromLatLon(latitude, longitude float64) (easting, northing float64, zoneNumber int, err error) {
+	zoneNumber, err = gk.ZoneNumber(longitude)
+	if err != nil {
+		return
+	}
+
+	easting, northing, err = gk.FromLatLonZone(latitude, longitude, zoneNumber)
+
+	return
+}
+
+// FromLatLonZone convert a latitude and longitude to Gauss–Krüger coordinates in the given zone.
+// Use it to continue a zone into its neighbours.
+func (gk GaussKrueger) FromLatLonZone(latitude, longitude float64, zoneNumber int) (easting, northing float64, err error) {
+	tm, err := gk.Zone(zoneNumber)
+	if err != nil {
+		return
+	}
+
+	return tm.FromLatLon(latitude, longitude)
+}
+
+// ToLatLon convert Gauss–Krüger coordinates to a latitude and longitude.
+// The zone is read from the prefix of the easting.
+func (gk GaussKrueger) ToLatLon(easting, northing float64) (latitude, longitude float64, err error) {
+	if err = gk.Validate(); err != nil {
+		return
+	}
+
+	first, last := gk.zoneRange()
+
+	zoneNumber := math.Floor(easting / 1000000)
+	if !(float64(first) <= zoneNumber && zoneNumber <= float64(last)) {
+		err = rangeError(ErrEastingOutOfRange, FieldEasting, easting, float64(first)*1000000, float64(last+1)*1000000)
+		return
+	}
+
+	tm, err := gk.Zone(int(zoneNumber))
+	if err != nil {
+		return
+	}
+
+	return tm.ToLatLon(easting, northing)
+}
@@ -0,0 +1,182 @@
+package UTM_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestGaussKruegerZoneNumber(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		gk         UTM.GaussKrueger
+		longitude  float64
+		zoneNumber int
+	}{
+		{UTM.GaussKrueger3, 6, 2},
+		{UTM.GaussKrueger3, 10.49, 3},
+		{UTM.GaussKrueger3, 10.5, 4},
+		{UTM.GaussKrueger3, 13.4, 4},
+		{UTM.GaussKrueger3, 0, 0},
+		{UTM.GaussKrueger3, -1.4, 0},
+		{UTM.GaussKrueger3, 1.5, 1},
+		{UTM.GaussKrueger3, -1.6, 119},
+		{UTM.GaussKrueger6, 0, 1},
+		{UTM.GaussKrueger6, 20, 4},
+		{UTM.GaussKrueger6, 37.6, 7},
+		{UTM.GaussKrueger6, 179.9, 30},
+		{UTM.GaussKrueger6, -180, 31},
+		{UTM.GaussKrueger6, -0.1, 60},
+	}
+
+	for _, c := range cases {
+		zoneNumber, err := c.gk.ZoneNumber(c.longitude)
+		if err != nil || zoneNumber != c.zoneNumber {
+			t.Errorf("%d degree zones, %f: %d %v, want %d", c.gk.ZoneWidth, c.longitude, zoneNumber, err, c.zoneNumber)
+		}
+
+		// the longitude lies in its zone
+		tm, err := c.gk.Zone(zoneNumber)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if d := math.Abs(math.Remainder(c.longitude-tm.CentralMeridian, 360)); d > float64(c.gk.ZoneWidth)/2 {
+			t.Errorf("%f is %f degrees from the central meridian of zone %d", c.longitude, d, zoneNumber)
+		}
+	}
+}
+
+func TestGaussKrueger(t *testing.T) {
+	t.Parallel()
+
+	// Berlin in 3 degree zone 4 on Bessel
+	easting, northing, zoneNumber, err := UTM.GaussKrueger3.FromLatLon(52.5163, 13.3777)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if zoneNumber != 4 || math.Floor(easting/1000000) != 4 {
+		t.Errorf("zone %d, easting %f", zoneNumber, easting)
+	}
+
+	latitude, longitude, err := UTM.GaussKrueger3.ToLatLon(easting, northing)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(latitude-52.5163) > 1e-12 || math.Abs(longitude-13.3777) > 1e-12 {
+		t.Errorf("(%f, %f)", latitude, longitude)
+	}
+
+	// 6 degree zone 3 and UTM zone 33 share the central meridian 15 deg E,
+	// so they differ by the scale factor only.
+	gk := UTM.GaussKrueger{ZoneWidth: 6, Ellipsoid: UTM.Bessel1841}
+
+	for _, latLon := range []testLatLon{{52.5163, 13.3777}, {47.3, 17.9}, {60, 12.1}} {
+		easting, northing, zoneNumber, err := gk.FromLatLon(latLon.Latitude, latLon.Longitude)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		utmEasting, utmNorthing, err := UTM.Bessel1841.FromLatLonZone(latLon.Latitude, latLon.Longitude, 33, "", UTM.DefaultZoneOffset, true)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		wantEasting := (utmEasting-500000)/0.9996 + 3500000
+		wantNorthing := utmNorthing / 0.9996
+
+		if zoneNumber != 3 || math.Abs(easting-wantEasting) > 1e-6 || math.Abs(northing-wantNorthing) > 1e-6 {
+			t.Errorf("%v: zone %d (%f, %f), want (%f, %f)", latLon, zoneNumber, easting, northing, wantEasting, wantNorthing)
+		}
+	}
+}
+
+func TestGaussKruegerZone(t *testing.T) {
+	t.Parallel()
+
+	// a point of zone 4 in zone 5
+	easting, northing, err := UTM.GaussKrueger3.FromLatLonZone(52.5163, 13.3777, 5)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Floor(easting/1000000) != 5 || easting > 5500000 {
+		t.Errorf("easting %f", easting)
+	}
+
+	latitude, longitude, err := UTM.GaussKrueger3.ToLatLon(easting, northing)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(latitude-52.5163) > 1e-12 || math.Abs(longitude-13.3777) > 1e-12 {
+		t.Errorf("(%f, %f)", latitude, longitude)
+	}
+
+	// zone 0 on Greenwich has no prefix on either side of the meridian
+	for _, longitude := range []float64{-1.2, 0.8} {
+		easting, northing, zoneNumber, err := UTM.GaussKrueger3.FromLatLon(51.4779, longitude)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if zoneNumber != 0 || !(0 < easting && easting < 1000000) {
+			t.Errorf("%f: zone %d, easting %f", longitude, zoneNumber, easting)
+		}
+
+		latitude, lon, err := UTM.GaussKrueger3.ToLatLon(easting, northing)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(latitude-51.4779) > 1e-12 || math.Abs(lon-longitude) > 1e-12 {
+			t.Errorf("(%f, %f)", latitude, lon)
+		}
+	}
+
+	tm, err := UTM.GaussKrueger6.Zone(4)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	want := UTM.TransverseMercator{CentralMeridian: 21, ScaleFactor: 1, FalseEasting: 4500000, Ellipsoid: UTM.Krassovsky1940}
+	if tm != want {
+		t.Errorf("zone 4: %+v", tm)
+	}
+}
+
+func TestGaussKruegerErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, _, _, err := (UTM.GaussKrueger{ZoneWidth: 4}).FromLatLon(52, 13); !errors.Is(err, UTM.ErrInvalidProjection) {
+		t.Errorf("zone width 4: %v", err)
+	}
+
+	if _, _, err := UTM.GaussKrueger6.FromLatLonZone(52, 13, 61); !errors.Is(err, UTM.ErrZoneNumberOutOfRange) {
+		t.Errorf("zone 61: %v", err)
+	}
+
+	if _, _, _, err := UTM.GaussKrueger3.FromLatLon(52, 181); !errors.Is(err, UTM.ErrLongitudeOutOfRange) {
+		t.Errorf("longitude 181: %v", err)
+	}
+
+	for _, easting := range []float64{-1, 120500000, math.NaN()} {
+		if _, _, err := UTM.GaussKrueger3.ToLatLon(easting, 5800000); !errors.Is(err, UTM.ErrEastingOutOfRange) {
+			t.Errorf("easting %f: %v", easting, err)
+		}
+	}
+
+	// 6 degree zones start at 1
+	if _, _, err := UTM.GaussKrueger6.ToLatLon(468123, 5800000); !errors.Is(err, UTM.ErrEastingOutOfRange) {
+		t.Errorf("easting 468123: %v", err)
+	}
+
+	if _, err := UTM.GaussKrueger3.Zone(120); !errors.Is(err, UTM.ErrZoneNumberOutOfRange) {
+		t.Errorf("zone 120: %v", err)
+	}
+}
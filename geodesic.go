@@ -0,0 +1,837 @@
+package UTM
+
+import "math"
+
+// The geodesic solver is a port of the series of C. F. F. Karney,
+// "Algorithms for geodesics", J. Geodesy 87, 43–55 (2013), as implemented in
+// GeographicLib, truncated at sixth order in the third flattening. It is
+// accurate to about 15 nm on ellipsoids like WGS84 and converges for nearly
+// antipodal points.
+
+const (
+	geodesicOrder = 6
+	nC3           = geodesicOrder
+
+	geodesicMaxit1 = 20
+	geodesicMaxit2 = geodesicMaxit1 + 53 + 10
+)
+
+var (
+	geodesicTol0   = math.Nextafter(1, 2) - 1
+	geodesicTol1   = 200 * geodesicTol0
+	geodesicTol2   = math.Sqrt(geodesicTol0)
+	geodesicTolb   = geodesicTol0 * geodesicTol2
+	geodesicXthres = 1000 * geodesicTol2
+	geodesicTiny   = math.Sqrt(0x1p-1022)
+)
+
+// geodesic holds the constants of the geodesic series of an ellipsoid.
+type geodesic struct {
+	a, f  float64
+	f1    float64 // 1 - f
+	ep2   float64 // second eccentricity squared
+	n     float64 // third flattening
+	b     float64 // semi-minor axis
+	etol2 float64
+	a3x   [geodesicOrder]float64
+	c3x   [(nC3 * (nC3 - 1)) / 2]float64
+}
+
+var a3Coeff = []float64{
+	-3, 128,
+	-2, -3, 64,
+	-1, -3, -1, 16,
+	3, -1, -2, 8,
+	1, -1, 2,
+	1, 1,
+}
+
+var c3Coeff = []float64{
+	3, 128,
+	2, 5, 128,
+	-1, 3, 3, 64,
+	-1, 0, 1, 8,
+	-1, 1, 4,
+	5, 256,
+	1, 3, 128,
+	-3, -2, 3, 64,
+	1, -3, 2, 32,
+	7, 512,
+	-10, 9, 384,
+	5, -9, 5, 192,
+	7, 512,
+	-14, 7, 512,
+	21, 2560,
+}
+
+func newGeodesic(a, f float64) geodesic {
+	g := geodesic{
+		a:   a,
+		f:   f,
+		f1:  1 - f,
+		ep2: f * (2 - f) / ((1 - f) * (1 - f)),
+		n:   f / (2 - f),
+		b:   a * (1 - f),
+	}
+
+	g.etol2 = 0.1 * geodesicTol2 / math.Sqrt(math.Max(0.001, math.Abs(f))*math.Min(1, 1-f/2)/2)
+
+	o, k := 0, 0
+	for j := geodesicOrder - 1; j >= 0; j-- {
+		m := geodesicOrder - j - 1
+		if j < m {
+			m = j
+		}
+		g.a3x[k] = polyval(m, a3Coeff, o, g.n) / a3Coeff[o+m+1]
+		k++
+		o += m + 2
+	}
+
+	o, k = 0, 0
+	for l := 1; l < nC3; l++ {
+		for j := nC3 - 1; j >= l; j-- {
+			m := nC3 - j - 1
+			if j < m {
+				m = j
+			}
+			g.c3x[k] = polyval(m, c3Coeff, o, g.n) / c3Coeff[o+m+1]
+			k++
+			o += m + 2
+		}
+	}
+
+	return g
+}
+
+// GeodesicInverse returns the length in metres of the shortest geodesic
+// between two points and its azimuths in degrees at both ends. The azimuth
+// at the second point is the direction of travel, not the back azimuth.
+// Latitudes must be in [-90, 90] and longitudes in [-180, 180].
+func GeodesicInverse(latitude1, longitude1, latitude2, longitude2 float64) (
+	distance, azimuth1, azimuth2 float64, err error,
+) {
+	return WGS84.GeodesicInverse(latitude1, longitude1, latitude2, longitude2)
+}
+
+// GeodesicInverse is GeodesicInverse on the ellipsoid.
+func (el *Ellipsoid) GeodesicInverse(latitude1, longitude1, latitude2, longitude2 float64) (
+	distance, azimuth1, azimuth2 float64, err error,
+) {
+	if err = validateGeodesicPoint(latitude1, longitude1); err != nil {
+		return
+	}
+	if err = validateGeodesicPoint(latitude2, longitude2); err != nil {
+		return
+	}
+
+	var salp1, calp1, salp2, calp2 float64
+	distance, salp1, calp1, salp2, calp2 = el.geodesic.inverse(latitude1, longitude1, latitude2, longitude2)
+
+	return distance, atan2d(salp1, calp1), atan2d(salp2, calp2), nil
+}
+
+// GeodesicDirect returns the end point of the geodesic of a length in metres
+// which starts at a point with an azimuth in degrees, and the azimuth at the
+// end point. The distance may be negative.
+func GeodesicDirect(latitude1, longitude1, azimuth1, distance float64) (
+	latitude2, longitude2, azimuth2 float64, err error,
+) {
+	return WGS84.GeodesicDirect(latitude1, longitude1, azimuth1, distance)
+}
+
+// GeodesicDirect is GeodesicDirect on the ellipsoid.
+func (el *Ellipsoid) GeodesicDirect(latitude1, longitude1, azimuth1, distance float64) (
+	latitude2, longitude2, azimuth2 float64, err error,
+) {
+	if err = validateGeodesicPoint(latitude1, longitude1); err != nil {
+		return
+	}
+
+	latitude2, longitude2, azimuth2 = el.geodesic.direct(latitude1, longitude1, azimuth1, distance)
+
+	return
+}
+
+func validateGeodesicPoint(latitude, longitude float64) error {
+	if !(-90 <= latitude && latitude <= 90) {
+		return rangeError(ErrLatitudeOutOfRange, FieldLatitude, latitude, -90, 90)
+	}
+	if !(-180 <= longitude && longitude <= 180) {
+		return rangeError(ErrLongitudeOutOfRange, FieldLongitude, longitude, -180, 180)
+	}
+	return nil
+}
+
+func (g *geodesic) direct(lat1, lon1, azi1, s12 float64) (lat2, lon2, azi2 float64) {
+	salp1, calp1 := sincosd(angRound(azi1))
+
+	sbet1, cbet1 := sincosd(angRound(lat1))
+	sbet1 *= g.f1
+	sbet1, cbet1 = norm(sbet1, cbet1)
+	cbet1 = math.Max(geodesicTiny, cbet1)
+
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+
+	ssig1 := sbet1
+	somg1 := salp0 * sbet1
+	csig1 := 1.0
+	if sbet1 != 0 || calp1 != 0 {
+		csig1 = cbet1 * calp1
+	}
+	comg1 := csig1
+	ssig1, csig1 = norm(ssig1, csig1)
+
+	k2 := calp0 * calp0 * g.ep2
+	eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+
+	a1m1 := a1m1f(eps)
+	var c1a, c1pa [geodesicOrder + 1]float64
+	c1f(eps, c1a[:])
+	c1pf(eps, c1pa[:])
+	var c3a [nC3]float64
+	g.c3f(eps, c3a[:])
+	a3c := -g.f * salp0 * g.a3f(eps)
+
+	b11 := sinCosSeries(ssig1, csig1, c1a[:])
+	s, c := math.Sincos(b11)
+	stau1 := ssig1*c + csig1*s
+	ctau1 := csig1*c - ssig1*s
+	b31 := sinCosSeries(ssig1, csig1, c3a[:])
+
+	tau12 := s12 / (g.b * (1 + a1m1))
+	s, c = math.Sincos(tau12)
+	b12 := -sinCosSeries(stau1*c+ctau1*s, ctau1*c-stau1*s, c1pa[:])
+	sig12 := tau12 - (b12 - b11)
+	ssig12, csig12 := math.Sincos(sig12)
+
+	if math.Abs(g.f) > 0.01 {
+		// one Newton step on the distance for strongly flattened ellipsoids
+		ssig2 := ssig1*csig12 + csig1*ssig12
+		csig2 := csig1*csig12 - ssig1*ssig12
+		b12 = sinCosSeries(ssig2, csig2, c1a[:])
+		serr := (1+a1m1)*(sig12+(b12-b11)) - s12/g.b
+		sig12 -= serr / math.Sqrt(1+k2*ssig2*ssig2)
+		ssig12, csig12 = math.Sincos(sig12)
+	}
+
+	ssig2 := ssig1*csig12 + csig1*ssig12
+	csig2 := csig1*csig12 - ssig1*ssig12
+
+	sbet2 := calp0 * ssig2
+	cbet2 := math.Hypot(salp0, calp0*csig2)
+	if cbet2 == 0 {
+		cbet2, csig2 = geodesicTiny, geodesicTiny
+	}
+	salp2 := salp0
+	calp2 := calp0 * csig2
+
+	somg2 := salp0 * ssig2
+	comg2 := csig2
+	omg12 := math.Atan2(somg2*comg1-comg2*somg1, comg2*comg1+somg2*somg1)
+	lam12 := omg12 + a3c*(sig12+(sinCosSeries(ssig2, csig2, c3a[:])-b31))
+
+	lon2 = angNormalize(angNormalize(lon1) + angNormalize(deg(lam12)))
+	lat2 = atan2d(sbet2, g.f1*cbet2)
+	azi2 = atan2d(salp2, calp2)
+
+	return
+}
+
+func (g *geodesic) inverse(lat1, lon1, lat2, lon2 float64) (s12, salp1, calp1, salp2, calp2 float64) {
+	lon12, lon12s := angDiff(lon1, lon2)
+	lonsign := 1.0
+	if lon12 < 0 {
+		lonsign = -1
+	}
+	lon12 = lonsign * angRound(lon12)
+	lon12s = angRound((180 - lon12) - lonsign*lon12s)
+	lam12 := rad(lon12)
+	var slam12, clam12 float64
+	if lon12 > 90 {
+		slam12, clam12 = sincosd(lon12s)
+		clam12 = -clam12
+	} else {
+		slam12, clam12 = sincosd(lon12)
+	}
+
+	lat1 = angRound(lat1)
+	lat2 = angRound(lat2)
+
+	// make lat1 <= 0 and |lat1| >= |lat2|
+	swapp := 1.0
+	if math.Abs(lat1) < math.Abs(lat2) {
+		swapp = -1
+		lonsign = -lonsign
+		lat1, lat2 = lat2, lat1
+	}
+	latsign := -1.0
+	if lat1 < 0 {
+		latsign = 1
+	}
+	lat1 *= latsign
+	lat2 *= latsign
+
+	sbet1, cbet1 := sincosd(lat1)
+	sbet1 *= g.f1
+	sbet1, cbet1 = norm(sbet1, cbet1)
+	cbet1 = math.Max(geodesicTiny, cbet1)
+
+	sbet2, cbet2 := sincosd(lat2)
+	sbet2 *= g.f1
+	sbet2, cbet2 = norm(sbet2, cbet2)
+	cbet2 = math.Max(geodesicTiny, cbet2)
+
+	if cbet1 < -sbet1 {
+		if cbet2 == cbet1 {
+			sbet2 = math.Copysign(sbet1, sbet2)
+		}
+	} else if math.Abs(sbet2) == -sbet1 {
+		cbet2 = cbet1
+	}
+
+	dn1 := math.Sqrt(1 + g.ep2*sbet1*sbet1)
+	dn2 := math.Sqrt(1 + g.ep2*sbet2*sbet2)
+
+	var sig12, s12x, m12x float64
+
+	meridian := lat1 == -90 || slam12 == 0
+	if meridian {
+		// the geodesic runs along a meridian
+		calp1, salp1 = clam12, slam12
+		calp2, salp2 = 1, 0
+
+		ssig1, csig1 := sbet1, calp1*cbet1
+		ssig2, csig2 := sbet2, calp2*cbet2
+
+		sig12 = math.Atan2(math.Max(0, csig1*ssig2-ssig1*csig2), csig1*csig2+ssig1*ssig2)
+		s12x, m12x = g.lengths(g.n, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2)
+
+		if sig12 < 1 || m12x >= 0 {
+			if sig12 < 3*geodesicTiny || (sig12 < geodesicTol0 && (s12x < 0 || m12x < 0)) {
+				sig12, m12x, s12x = 0, 0, 0
+			}
+			s12x *= g.b
+		} else {
+			// the meridian is not the shortest path, past the pole
+			meridian = false
+		}
+	}
+
+	switch {
+	case meridian:
+	case sbet1 == 0 && (g.f <= 0 || lon12s >= g.f*180):
+		// the geodesic runs along the equator
+		calp1, calp2 = 0, 0
+		salp1, salp2 = 1, 1
+		s12x = g.a * lam12
+	default:
+		var dnm float64
+		sig12, salp1, calp1, salp2, calp2, dnm = g.inverseStart(sbet1, cbet1, dn1, sbet2, cbet2, dn2, lam12, slam12, clam12)
+
+		if sig12 >= 0 {
+			// short line
+			s12x = sig12 * g.b * dnm
+			break
+		}
+
+		// Newton's method on the azimuth at the first point, bracketed by
+		// bisection
+		var ssig1, csig1, ssig2, csig2, eps float64
+		numit := 0
+		tripn, tripb := false, false
+		salp1a, calp1a := geodesicTiny, 1.0
+		salp1b, calp1b := geodesicTiny, -1.0
+
+		for ; numit < geodesicMaxit2; numit++ {
+			var v, dv float64
+			v, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, dv = g.lambda12(
+				sbet1, cbet1, dn1, sbet2, cbet2, dn2, salp1, calp1, slam12, clam12, numit < geodesicMaxit1)
+
+			tol := geodesicTol0
+			if tripn {
+				tol *= 8
+			}
+			if tripb || !(math.Abs(v) >= tol) {
+				break
+			}
+
+			if v > 0 && (numit > geodesicMaxit1 || calp1/salp1 > calp1b/salp1b) {
+				salp1b, calp1b = salp1, calp1
+			} else if v < 0 && (numit > geodesicMaxit1 || calp1/salp1 < calp1a/salp1a) {
+				salp1a, calp1a = salp1, calp1
+			}
+
+			if numit < geodesicMaxit1 && dv > 0 {
+				dalp1 := -v / dv
+				sdalp1, cdalp1 := math.Sincos(dalp1)
+				nsalp1 := salp1*cdalp1 + calp1*sdalp1
+				if nsalp1 > 0 && math.Abs(dalp1) < math.Pi {
+					calp1 = calp1*cdalp1 - salp1*sdalp1
+					salp1 = nsalp1
+					salp1, calp1 = norm(salp1, calp1)
+					tripn = math.Abs(v) <= 16*geodesicTol0
+					continue
+				}
+			}
+
+			salp1 = (salp1a + salp1b) / 2
+			calp1 = (calp1a + calp1b) / 2
+			salp1, calp1 = norm(salp1, calp1)
+			tripn = false
+			tripb = math.Abs(salp1a-salp1)+(calp1a-calp1) < geodesicTolb ||
+				math.Abs(salp1-salp1b)+(calp1-calp1b) < geodesicTolb
+		}
+
+		s12x, _ = g.lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2)
+		s12x *= g.b
+	}
+
+	s12 = 0 + s12x
+
+	if swapp < 0 {
+		salp1, salp2 = salp2, salp1
+		calp1, calp2 = calp2, calp1
+	}
+
+	salp1 *= swapp * lonsign
+	calp1 *= swapp * latsign
+	salp2 *= swapp * lonsign
+	calp2 *= swapp * latsign
+
+	return
+}
+
+// lengths returns the distance and the reduced length of a geodesic
+// segment divided by b.
+func (g *geodesic) lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2 float64) (s12b, m12b float64) {
+	var c1a, c2a [geodesicOrder + 1]float64
+
+	a1 := a1m1f(eps)
+	c1f(eps, c1a[:])
+	a2 := a2m1f(eps)
+	c2f(eps, c2a[:])
+	m0x := a1 - a2
+	a2 = 1 + a2
+	a1 = 1 + a1
+
+	b1 := sinCosSeries(ssig2, csig2, c1a[:]) - sinCosSeries(ssig1, csig1, c1a[:])
+	s12b = a1 * (sig12 + b1)
+
+	b2 := sinCosSeries(ssig2, csig2, c2a[:]) - sinCosSeries(ssig1, csig1, c2a[:])
+	j12 := m0x*sig12 + (a1*b1 - a2*b2)
+
+	m12b = dn2*(csig1*ssig2) - dn1*(ssig1*csig2) - csig1*csig2*j12
+
+	return
+}
+
+// inverseStart returns a starting azimuth for Newton's method, or the
+// solution for short lines with sig12 >= 0.
+func (g *geodesic) inverseStart(sbet1, cbet1, dn1, sbet2, cbet2, dn2, lam12, slam12, clam12 float64) (
+	sig12, salp1, calp1, salp2, calp2, dnm float64,
+) {
+	sig12 = -1
+
+	sbet12 := sbet2*cbet1 - cbet2*sbet1
+	cbet12 := cbet2*cbet1 + sbet2*sbet1
+	sbet12a := sbet2*cbet1 + cbet2*sbet1
+
+	shortline := cbet12 >= 0 && sbet12 < 0.5 && cbet2*lam12 < 0.5
+
+	var somg12, comg12 float64
+	if shortline {
+		sbetm2 := (sbet1 + sbet2) * (sbet1 + sbet2)
+		sbetm2 /= sbetm2 + (cbet1+cbet2)*(cbet1+cbet2)
+		dnm = math.Sqrt(1 + g.ep2*sbetm2)
+		omg12 := lam12 / (g.f1 * dnm)
+		somg12, comg12 = math.Sincos(omg12)
+	} else {
+		somg12, comg12 = slam12, clam12
+	}
+
+	salp1 = cbet2 * somg12
+	if comg12 >= 0 {
+		calp1 = sbet12 + cbet2*sbet1*somg12*somg12/(1+comg12)
+	} else {
+		calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+	}
+
+	ssig12 := math.Hypot(salp1, calp1)
+	csig12 := sbet1*sbet2 + cbet1*cbet2*comg12
+
+	switch {
+	case shortline && ssig12 < g.etol2:
+		salp2 = cbet1 * somg12
+		if comg12 >= 0 {
+			calp2 = sbet12 - cbet1*sbet2*somg12*somg12/(1+comg12)
+		} else {
+			calp2 = sbet12 - cbet1*sbet2*(1-comg12)
+		}
+		salp2, calp2 = norm(salp2, calp2)
+		sig12 = math.Atan2(ssig12, csig12)
+	case math.Abs(g.n) >= 0.1 || csig12 >= 0 || ssig12 >= 6*math.Abs(g.n)*math.Pi*cbet1*cbet1:
+		// the spherical estimate is good enough
+	default:
+		// nearly antipodal points, solve the astroid problem
+		lam12x := math.Atan2(-slam12, -clam12)
+		k2 := sbet1 * sbet1 * g.ep2
+		eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+		lamscale := g.f * cbet1 * g.a3f(eps) * math.Pi
+		betscale := lamscale * cbet1
+		x := lam12x / lamscale
+		y := sbet12a / betscale
+
+		if y > -geodesicTol1 && x > -1-geodesicXthres {
+			salp1 = math.Min(1, -x)
+			calp1 = -math.Sqrt(1 - salp1*salp1)
+		} else {
+			k := astroid(x, y)
+			omg12a := lamscale * (-x * k / (1 + k))
+			somg12, comg12 = math.Sincos(omg12a)
+			comg12 = -comg12
+			salp1 = cbet2 * somg12
+			calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+		}
+	}
+
+	if !(salp1 <= 0) {
+		salp1, calp1 = norm(salp1, calp1)
+	} else {
+		salp1, calp1 = 1, 0
+	}
+
+	return
+}
+
+// lambda12 returns the longitude difference of the geodesic with the given
+// azimuth at the first point minus the wanted one, and with diffp its
+// derivative with respect to the azimuth.
+func (g *geodesic) lambda12(sbet1, cbet1, dn1, sbet2, cbet2, dn2, salp1, calp1, slam120, clam120 float64, diffp bool) (
+	lam12, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, dlam12 float64,
+) {
+	if sbet1 == 0 && calp1 == 0 {
+		// break the degeneracy of equatorial lines
+		calp1 = -geodesicTiny
+	}
+
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+
+	ssig1 = sbet1
+	somg1 := salp0 * sbet1
+	csig1 = calp1 * cbet1
+	comg1 := csig1
+	ssig1, csig1 = norm(ssig1, csig1)
+
+	if cbet2 != cbet1 {
+		salp2 = salp0 / cbet2
+	} else {
+		salp2 = salp1
+	}
+
+	if cbet2 != cbet1 || math.Abs(sbet2) != -sbet1 {
+		var t float64
+		if cbet1 < -sbet1 {
+			t = (cbet2 - cbet1) * (cbet1 + cbet2)
+		} else {
+			t = (sbet1 - sbet2) * (sbet1 + sbet2)
+		}
+		calp2 = math.Sqrt(calp1*cbet1*calp1*cbet1+t) / cbet2
+	} else {
+		calp2 = math.Abs(calp1)
+	}
+
+	ssig2 = sbet2
+	somg2 := salp0 * sbet2
+	csig2 = calp2 * cbet2
+	comg2 := csig2
+	ssig2, csig2 = norm(ssig2, csig2)
+
+	sig12 = math.Atan2(math.Max(0, csig1*ssig2-ssig1*csig2), csig1*csig2+ssig1*ssig2)
+
+	somg12 := math.Max(0, comg1*somg2-somg1*comg2)
+	comg12 := comg1*comg2 + somg1*somg2
+	eta := math.Atan2(somg12*clam120-comg12*slam120, comg12*clam120+somg12*slam120)
+
+	k2 := calp0 * calp0 * g.ep2
+	eps = k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+
+	var c3a [nC3]float64
+	g.c3f(eps, c3a[:])
+	b312 := sinCosSeries(ssig2, csig2, c3a[:]) - sinCosSeries(ssig1, csig1, c3a[:])
+	domg12 := -g.f * g.a3f(eps) * salp0 * (sig12 + b312)
+	lam12 = eta + domg12
+
+	if diffp {
+		if calp2 == 0 {
+			dlam12 = -2 * g.f1 * dn1 / sbet1
+		} else {
+			_, dlam12 = g.lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2)
+			dlam12 *= g.f1 / (calp2 * cbet2)
+		}
+	} else {
+		dlam12 = math.NaN()
+	}
+
+	return
+}
+
+func (g *geodesic) a3f(eps float64) float64 {
+	return polyval(geodesicOrder-1, g.a3x[:], 0, eps)
+}
+
+func (g *geodesic) c3f(eps float64, c []float64) {
+	mult := 1.0
+	o := 0
+	for l := 1; l < nC3; l++ {
+		m := nC3 - l - 1
+		mult *= eps
+		c[l] = mult * polyval(m, g.c3x[:], o, eps)
+		o += m + 1
+	}
+}
+
+func a1m1f(eps float64) float64 {
+	coeff := [...]float64{1, 4, 64, 0, 256}
+	m := geodesicOrder / 2
+	t := polyval(m, coeff[:], 0, eps*eps) / coeff[m+1]
+	return (t + eps) / (1 - eps)
+}
+
+func a2m1f(eps float64) float64 {
+	coeff := [...]float64{-11, -28, -192, 0, 256}
+	m := geodesicOrder / 2
+	t := polyval(m, coeff[:], 0, eps*eps) / coeff[m+1]
+	return (t - eps) / (1 + eps)
+}
+
+var c1Coeff = []float64{
+	-1, 6, -16, 32,
+	-9, 64, -128, 2048,
+	9, -16, 768,
+	3, -5, 512,
+	-7, 1280,
+	-7, 2048,
+}
+
+var c1pCoeff = []float64{
+	205, -432, 768, 1536,
+	4005, -4736, 3840, 12288,
+	-225, 116, 384,
+	-7173, 2695, 7680,
+	3467, 7680,
+	38081, 61440,
+}
+
+var c2Coeff = []float64{
+	1, 2, 16, 32,
+	35, 64, 384, 2048,
+	15, 80, 768,
+	7, 35, 512,
+	63, 1280,
+	77, 2048,
+}
+
+func c1f(eps float64, c []float64)  { oddSeries(c1Coeff, eps, c) }
+func c1pf(eps float64, c []float64) { oddSeries(c1pCoeff, eps, c) }
+func c2f(eps float64, c []float64)  { oddSeries(c2Coeff, eps, c) }
+
+// oddSeries evaluates the coefficients c[1:] of a Fourier series whose l-th
+// coefficient is eps^l times a polynomial in eps².
+func oddSeries(coeff []float64, eps float64, c []float64) {
+	eps2 := eps * eps
+	d := eps
+	o := 0
+	for l := 1; l <= geodesicOrder; l++ {
+		m := (geodesicOrder - l) / 2
+		c[l] = d * polyval(m, coeff, o, eps2) / coeff[o+m+1]
+		o += m + 2
+		d *= eps
+	}
+}
+
+// sinCosSeries evaluates sum c[l] sin(2 l x) for l from 1 by Clenshaw
+// summation, given sin x and cos x.
+func sinCosSeries(sinx, cosx float64, c []float64) float64 {
+	k := len(c)
+	n := k - 1
+	ar := 2 * (cosx - sinx) * (cosx + sinx)
+
+	var y0, y1 float64
+	if n&1 != 0 {
+		k--
+		y0 = c[k]
+	}
+	for n /= 2; n > 0; n-- {
+		k--
+		y1 = ar*y0 - y1 + c[k]
+		k--
+		y0 = ar*y1 - y0 + c[k]
+	}
+
+	return 2 * sinx * cosx * y0
+}
+
+// astroid solves k⁴ + 2k³ - (x² + y² - 1)k² - 2y²k - y² = 0 for the positive root.
+func astroid(x, y float64) float64 {
+	p := x * x
+	q := y * y
+	r := (p + q - 1) / 6
+
+	if q == 0 && r <= 0 {
+		return 0
+	}
+
+	s := p * q / 4
+	r2 := r * r
+	r3 := r * r2
+	disc := s * (s + 2*r3)
+	u := r
+	if disc >= 0 {
+		t3 := s + r3
+		if t3 < 0 {
+			t3 -= math.Sqrt(disc)
+		} else {
+			t3 += math.Sqrt(disc)
+		}
+		t := math.Cbrt(t3)
+		u += t
+		if t != 0 {
+			u += r2 / t
+		}
+	} else {
+		ang := math.Atan2(math.Sqrt(-disc), -(s + r3))
+		u += 2 * r * math.Cos(ang/3)
+	}
+
+	v := math.Sqrt(u*u + q)
+	var uv float64
+	if u < 0 {
+		uv = q / (v - u)
+	} else {
+		uv = u + v
+	}
+	w := (uv - q) / (2 * v)
+
+	return uv / (math.Sqrt(uv+w*w) + w)
+}
+
+// polyval evaluates the polynomial of degree n with coefficients p[s:s+n+1],
+// highest power first.
+func polyval(n int, p []float64, s int, x float64) float64 {
+	if n < 0 {
+		return 0
+	}
+	y := p[s]
+	for ; n > 0; n-- {
+		s++
+		y = y*x + p[s]
+	}
+	return y
+}
+
+// sum returns u + v and the rounding error of the addition.
+func sum(u, v float64) (s, t float64) {
+	s = u + v
+	up := s - v
+	vpp := s - up
+	up -= u
+	vpp -= v
+	t = -(up + vpp)
+	return
+}
+
+// angRound rounds tiny angles so that they are exact multiples of a small
+// power of two, which keeps the solver symmetric.
+func angRound(x float64) float64 {
+	const z = 1.0 / 16
+	y := math.Abs(x)
+	if y < z {
+		y = z - (z - y)
+	}
+	return math.Copysign(y, x)
+}
+
+// angNormalize wraps an angle in degrees to [-180, 180].
+func angNormalize(x float64) float64 {
+	y := math.Remainder(x, 360)
+	if math.Abs(y) == 180 {
+		return math.Copysign(180, x)
+	}
+	return y
+}
+
+// angDiff returns y - x wrapped to [-180, 180] and its rounding error.
+func angDiff(x, y float64) (d, e float64) {
+	d, e = sum(math.Remainder(-x, 360), math.Remainder(y, 360))
+	d, e = sum(math.Remainder(d, 360), e)
+	if d == 0 || math.Abs(d) == 180 {
+		if e == 0 {
+			d = math.Copysign(d, y-x)
+		} else {
+			d = math.Copysign(d, -e)
+		}
+	}
+	return
+}
+
+// sincosd returns the sine and cosine of an angle in degrees, exact for
+// multiples of 90 degrees.
+func sincosd(x float64) (sinx, cosx float64) {
+	r := math.Mod(x, 360)
+	q := 0
+	if !math.IsNaN(r) {
+		q = int(math.Round(r / 90))
+	}
+	r -= 90 * float64(q)
+	s, c := math.Sincos(rad(r))
+
+	switch ((q % 4) + 4) % 4 {
+	case 0:
+		sinx, cosx = s, c
+	case 1:
+		sinx, cosx = c, -s
+	case 2:
+		sinx, cosx = -s, -c
+	default:
+		sinx, cosx = -c, s
+	}
+
+	return sinx, cosx + 0
+}
+
+// atan2d returns atan2(y, x) in degrees in [-180, 180], exact for multiples
+// of 45 degrees.
+func atan2d(y, x float64) float64 {
+	q := 0
+	if math.Abs(y) > math.Abs(x) {
+		q = 2
+		x, y = y, x
+	}
+	if x < 0 {
+		q++
+		x = -x
+	}
+
+	ang := deg(math.Atan2(y, x))
+	switch q {
+	case 1:
+		if y >= 0 {
+			ang = 180 - ang
+		} else {
+			ang = -180 - ang
+		}
+	case 2:
+		ang = 90 - ang
+	case 3:
+		ang = -90 + ang
+	}
+
+	return ang
+}
+
+func norm(x, y float64) (float64, float64) {
+	r := math.Hypot(x, y)
+	return x / r, y / r
+}
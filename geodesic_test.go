@@ -0,0 +1,145 @@
+package UTM_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestGeodesicInverse(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		latitude1, longitude1, latitude2, longitude2 float64
+		distance, azimuth1, azimuth2                 float64
+	}{
+		// Wellington to Salamanca, Karney 2013
+		{-41.32, 174.81, 40.96, -5.50, 19959679.267353, 161.067669986160, 18.825195123247},
+		// JFK to LHR
+		{40.6, -73.8, 51.6, -0.5, 5551759.400319, 51.198882845579824, 107.821776735514},
+	}
+
+	for _, c := range cases {
+		distance, azimuth1, azimuth2, err := UTM.GeodesicInverse(c.latitude1, c.longitude1, c.latitude2, c.longitude2)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(distance-c.distance) > 1e-6 || math.Abs(azimuth1-c.azimuth1) > 1e-11 || math.Abs(azimuth2-c.azimuth2) > 1e-11 {
+			t.Errorf("(%f, %f) to (%f, %f): (%.6f, %.12f, %.12f), want (%.6f, %.12f, %.12f)",
+				c.latitude1, c.longitude1, c.latitude2, c.longitude2,
+				distance, azimuth1, azimuth2, c.distance, c.azimuth1, c.azimuth2)
+		}
+	}
+}
+
+func TestGeodesicSpecialCases(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		latitude1, longitude1, latitude2, longitude2 float64
+		distance, azimuth1                           float64
+	}{
+		// along the equator, a quarter of its length
+		{0, 0, 0, 90, 6378137 * math.Pi / 2, 90},
+		// a quarter meridian
+		{0, 0, 90, 0, 10001965.7293127, 0},
+		{0, 0, -90, 0, 10001965.7293127, 180},
+		// antipodal points on the equator are joined over the poles
+		{0, 0, 0, 180, 20003931.458625, 0},
+		// coincident points
+		{52.5, 13.4, 52.5, 13.4, 0, 180},
+	}
+
+	for _, c := range cases {
+		distance, azimuth1, _, err := UTM.GeodesicInverse(c.latitude1, c.longitude1, c.latitude2, c.longitude2)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(distance-c.distance) > 1e-6 || math.Abs(math.Abs(azimuth1)-c.azimuth1) > 1e-9 {
+			t.Errorf("(%f, %f) to (%f, %f): (%.6f, %.12f), want (%.6f, %.12f)",
+				c.latitude1, c.longitude1, c.latitude2, c.longitude2, distance, azimuth1, c.distance, c.azimuth1)
+		}
+	}
+}
+
+func TestGeodesicRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	points := []struct{ latitude1, longitude1, latitude2, longitude2 float64 }{
+		{-41.32, 174.81, 40.96, -5.50},
+		{40.6, -73.8, 51.6, -0.5},
+		{50.77535, 6.08389, 50.77536, 6.08390},
+		{-33.9, 18.42, 35.7, 139.7},
+		// nearly antipodal
+		{0, 0, 0.5, 179.5},
+		{-30, 0, 29.9, 179.8},
+		{10, 0, -10.001, -179.99},
+		{89.9, 45, -89.9, -135},
+	}
+
+	for _, p := range points {
+		distance, azimuth1, azimuth2, err := UTM.GeodesicInverse(p.latitude1, p.longitude1, p.latitude2, p.longitude2)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		latitude, longitude, azimuth, err := UTM.GeodesicDirect(p.latitude1, p.longitude1, azimuth1, distance)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		// 1e-12 degrees is about 0.1 µm
+		if math.Abs(latitude-p.latitude2) > 1e-11 || math.Abs(math.Remainder(longitude-p.longitude2, 360)) > 1e-11 ||
+			math.Abs(math.Remainder(azimuth-azimuth2, 360)) > 1e-9 {
+			t.Errorf("%v: direct (%.12f, %.12f, %.12f), want azimuth %.12f", p, latitude, longitude, azimuth, azimuth2)
+		}
+
+		// the geodesic backwards has the same length
+		back, _, _, _ := UTM.GeodesicInverse(p.latitude2, p.longitude2, p.latitude1, p.longitude1)
+		if math.Abs(back-distance) > 1e-8 {
+			t.Errorf("%v: %f forwards, %f backwards", p, distance, back)
+		}
+	}
+}
+
+func TestGeodesicEllipsoid(t *testing.T) {
+	t.Parallel()
+
+	// the geodesic solver uses the ellipsoid of the conversions
+	wgs84, _, _, _ := UTM.WGS84.GeodesicInverse(0, 0, 90, 0)
+	bessel, _, _, _ := UTM.Bessel1841.GeodesicInverse(0, 0, 90, 0)
+
+	if math.Abs(bessel-10000855.7644) > 1e-3 || wgs84 == bessel {
+		t.Errorf("Bessel quarter meridian %f", bessel)
+	}
+
+	// short lines agree with the UTM traverse
+	start, _ := UTM.Geodetic{Latitude: 50.77535, Longitude: 6.08389}.Point()
+	end, _ := UTM.Traverse(start, 5000, 30, 0)
+	g, _ := end.Geodetic()
+
+	distance, azimuth, _, _ := UTM.GeodesicInverse(50.77535, 6.08389, g.Latitude, g.Longitude)
+	if math.Abs(distance-5000) > 0.002 || math.Abs(azimuth-30)*3600 > 0.1 {
+		t.Errorf("traverse of 5000 m at 30 deg: geodesic (%f, %f)", distance, azimuth)
+	}
+}
+
+func TestGeodesicErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, _, _, err := UTM.GeodesicInverse(91, 0, 0, 0); !errors.Is(err, UTM.ErrLatitudeOutOfRange) {
+		t.Errorf("%v is not %v", err, UTM.ErrLatitudeOutOfRange)
+	}
+
+	if _, _, _, err := UTM.GeodesicInverse(0, 0, 0, 181); !errors.Is(err, UTM.ErrLongitudeOutOfRange) {
+		t.Errorf("%v is not %v", err, UTM.ErrLongitudeOutOfRange)
+	}
+
+	if _, _, _, err := UTM.GeodesicDirect(math.NaN(), 0, 0, 1000); !errors.Is(err, UTM.ErrLatitudeOutOfRange) {
+		t.Errorf("%v is not %v", err, UTM.ErrLatitudeOutOfRange)
+	}
+}
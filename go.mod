@@ -1 +1,3 @@
 module github.com/im7mortal/UTM
+
+go 1.13
@@ -0,0 +1,157 @@
+package UTM
+
+import (
+	"strconv"
+	"strings"
+)
+
+// GridZone is a grid zone of the UTM system, the part of a zone inside one
+// latitude band, such as 32V. Its designator is String.
+type GridZone struct {
+	ZoneNumber int
+	Band       byte
+}
+
+// GridZoneOf returns the grid zone a latitude and longitude falls into, the
+// same FromLatLon picks.
+func GridZoneOf(latitude, longitude float64) (GridZone, error) {
+	if err := ValidateLatLone(latitude, longitude); err != nil {
+		return GridZone{}, err
+	}
+
+	return GridZone{
+		ZoneNumber: latLonToZoneNumber(latitude, longitude),
+		Band:       latitudeToZoneLetter(latitude)[0],
+	}, nil
+}
+
+// ParseGridZone parses a grid zone designator such as "32V". Designators of
+// grid zones that don't exist, such as 32X, return an error wrapping
+// ErrInvalidGridZone.
+func ParseGridZone(s string) (GridZone, error) {
+	t := strings.ToUpper(strings.TrimSpace(s))
+	if len(t) < 2 {
+		return GridZone{}, inputError(ErrInvalidGridZone, FieldNone, strconv.Quote(s))
+	}
+
+	zoneNumber, err := strconv.Atoi(t[:len(t)-1])
+	if err != nil {
+		return GridZone{}, inputError(ErrInvalidGridZone, FieldNone, strconv.Quote(s))
+	}
+
+	z := GridZone{ZoneNumber: zoneNumber, Band: t[len(t)-1]}
+	if !z.Valid() {
+		return GridZone{}, inputError(ErrInvalidGridZone, FieldNone, strconv.Quote(s))
+	}
+
+	return z, nil
+}
+
+// GridZones returns all grid zones ordered by zone number and from south to
+// north. 32X, 34X and 36X are missing because the Svalbard zones 31X, 33X,
+// 35X and 37X cover them.
+func GridZones() []GridZone {
+	zones := make([]GridZone, 0, 60*20-3)
+
+	for zoneNumber := 1; zoneNumber <= 60; zoneNumber++ {
+		for i := len(zoneLetters) - 1; i >= 1; i-- {
+			z := GridZone{zoneNumber, zoneLetters[i].letter[0]}
+			if z.Valid() {
+				zones = append(zones, z)
+			}
+		}
+	}
+
+	return zones
+}
+
+// String returns the grid zone designator, e.g. "32V".
+func (z GridZone) String() string {
+	return strconv.Itoa(z.ZoneNumber) + string(z.Band)
+}
+
+// Valid reports whether the grid zone exists.
+func (z GridZone) Valid() bool {
+	if !(1 <= z.ZoneNumber && z.ZoneNumber <= 60) {
+		return false
+	}
+
+	if !('C' <= z.Band && z.Band <= 'X') || z.Band == 'I' || z.Band == 'O' {
+		return false
+	}
+
+	return !(z.Band == 'X' && (z.ZoneNumber == 32 || z.ZoneNumber == 34 || z.ZoneNumber == 36))
+}
+
+// CentralMeridian returns the longitude of the central meridian of the zone.
+// The widened zones 32V and 31X to 37X keep the central meridian of their
+// zone number.
+func (z GridZone) CentralMeridian() float64 {
+	return float64(zoneNumberToCentralLongitude(z.ZoneNumber))
+}
+
+// Bounds returns the latitudes and longitudes of the edges of the grid zone.
+// Most grid zones span 6 degrees of longitude and 8 of latitude, the X band 12.
+// 31V is narrowed to 0 to 3 degrees east in favour of 32V, which spans
+// 3 to 12 degrees east, and the Svalbard zones 31X, 33X, 35X and 37X are
+// 9 or 12 degrees wide.
+func (z GridZone) Bounds() (south, north, west, east float64) {
+	south, north = bandLatitudes(z.Band)
+
+	west = float64((z.ZoneNumber-1)*6 - 180)
+	east = west + 6
+
+	switch z.String() {
+	case "31V":
+		east = 3
+	case "32V":
+		west = 3
+	case "31X":
+		east = 9
+	case "33X":
+		west, east = 9, 21
+	case "35X":
+		west, east = 21, 33
+	case "37X":
+		west = 33
+	}
+
+	return
+}
+
+// Contains reports whether a latitude and longitude falls into the grid zone,
+// that is whether GridZoneOf returns it.
+func (z GridZone) Contains(latitude, longitude float64) bool {
+	other, err := GridZoneOf(latitude, longitude)
+	return err == nil && other == z
+}
+
+// Neighbours returns the grid zones sharing an edge with the grid zone, across
+// the antimeridian as well, in the order of GridZones. Because of the
+// irregular zones around Norway and Svalbard there may be more than one
+// neighbour on a side.
+func (z GridZone) Neighbours() []GridZone {
+	south, north, west, east := z.Bounds()
+
+	var neighbours []GridZone
+	for _, other := range GridZones() {
+		if other == z {
+			continue
+		}
+
+		otherSouth, otherNorth, otherWest, otherEast := other.Bounds()
+
+		latitudeOverlap := otherSouth < north && south < otherNorth
+		longitudeOverlap := otherWest < east && west < otherEast
+
+		sideBySide := latitudeOverlap &&
+			(otherWest == east || otherEast == west || (east == 180 && otherWest == -180) || (west == -180 && otherEast == 180))
+		stacked := longitudeOverlap && (otherSouth == north || otherNorth == south)
+
+		if sideBySide || stacked {
+			neighbours = append(neighbours, other)
+		}
+	}
+
+	return neighbours
+}
@@ -0,0 +1,166 @@
+package UTM_test
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestGridZones(t *testing.T) {
+	t.Parallel()
+
+	zones := UTM.GridZones()
+	if len(zones) != 1197 {
+		t.Errorf("%d grid zones, want 1197", len(zones))
+	}
+
+	if zones[0].String() != "1C" || zones[len(zones)-1].String() != "60X" {
+		t.Errorf("first %s, last %s", zones[0], zones[len(zones)-1])
+	}
+
+	for _, z := range zones {
+		switch z.String() {
+		case "32X", "34X", "36X":
+			t.Errorf("%s listed", z)
+		}
+
+		// the centre of every grid zone lies in it
+		south, north, west, east := z.Bounds()
+		if !z.Contains((south+north)/2, (west+east)/2) {
+			t.Errorf("%s does not contain its centre", z)
+		}
+	}
+}
+
+func TestGridZoneBounds(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		designator               string
+		south, north, west, east float64
+		centralMeridian          float64
+	}{
+		{"1C", -80, -72, -180, -174, -177},
+		{"33U", 48, 56, 12, 18, 15},
+		{"31V", 56, 64, 0, 3, 3},
+		{"32V", 56, 64, 3, 12, 9},
+		{"32W", 64, 72, 6, 12, 9},
+		{"31X", 72, 84, 0, 9, 3},
+		{"33X", 72, 84, 9, 21, 15},
+		{"35X", 72, 84, 21, 33, 27},
+		{"37X", 72, 84, 33, 42, 39},
+		{"60X", 72, 84, 174, 180, 177},
+	}
+
+	for _, c := range cases {
+		z, err := UTM.ParseGridZone(c.designator)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		south, north, west, east := z.Bounds()
+		if south != c.south || north != c.north || west != c.west || east != c.east {
+			t.Errorf("%s: bounds %f %f %f %f", c.designator, south, north, west, east)
+		}
+
+		if z.CentralMeridian() != c.centralMeridian {
+			t.Errorf("%s: central meridian %f", c.designator, z.CentralMeridian())
+		}
+	}
+}
+
+func TestGridZoneOf(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range getTestValues() {
+		z, err := UTM.GridZoneOf(data.LatLon.Latitude, data.LatLon.Longitude)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if z.ZoneNumber != data.UTM.ZoneNumber || string(z.Band) != data.UTM.ZoneLetter {
+			t.Errorf("%v: %s, want %d%s", data.LatLon, z, data.UTM.ZoneNumber, data.UTM.ZoneLetter)
+		}
+	}
+
+	cases := []struct {
+		latitude, longitude float64
+		designator          string
+	}{
+		{60, 4, "32V"},
+		{60, 2.9, "31V"},
+		{78, 8.9, "31X"},
+		{78, 9, "33X"},
+		{84, 41.9, "37X"},
+		{0, 180, "1N"},
+		{-80, -180, "1C"},
+	}
+
+	for _, c := range cases {
+		z, err := UTM.GridZoneOf(c.latitude, c.longitude)
+		if err != nil || z.String() != c.designator {
+			t.Errorf("(%f, %f): %s %v, want %s", c.latitude, c.longitude, z, err, c.designator)
+		}
+
+		if !z.Contains(c.latitude, c.longitude) {
+			t.Errorf("%s does not contain (%f, %f)", z, c.latitude, c.longitude)
+		}
+	}
+
+	if _, err := UTM.GridZoneOf(85, 0); !errors.Is(err, UTM.ErrLatitudeOutOfRange) {
+		t.Errorf("GridZoneOf: %v", err)
+	}
+}
+
+func TestParseGridZone(t *testing.T) {
+	t.Parallel()
+
+	if z, err := UTM.ParseGridZone(" 4q"); err != nil || z != (UTM.GridZone{ZoneNumber: 4, Band: 'Q'}) {
+		t.Errorf("ParseGridZone: %v %v", z, err)
+	}
+
+	for _, s := range []string{"", "V", "32", "32X", "34X", "36X", "61U", "0U", "33I", "33Y", "33UU"} {
+		if _, err := UTM.ParseGridZone(s); !errors.Is(err, UTM.ErrInvalidGridZone) {
+			t.Errorf("%q: %v", s, err)
+		}
+	}
+}
+
+func TestGridZoneNeighbours(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		designator string
+		neighbours []string
+	}{
+		{"33U", []string{"32U", "33T", "33V", "34U"}},
+		{"1C", []string{"1D", "2C", "60C"}},
+		{"60X", []string{"1X", "59X", "60W"}},
+		{"32V", []string{"31U", "31V", "31W", "32U", "32W", "33V"}},
+		{"31V", []string{"30V", "31U", "31W", "32V"}},
+		{"31W", []string{"31V", "31X", "32V", "32W", "30W"}},
+		{"33X", []string{"33W", "34W", "31X", "35X", "32W"}},
+	}
+
+	for _, c := range cases {
+		z, err := UTM.ParseGridZone(c.designator)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		var neighbours []string
+		for _, n := range z.Neighbours() {
+			neighbours = append(neighbours, n.String())
+		}
+
+		sort.Strings(neighbours)
+		sort.Strings(c.neighbours)
+
+		if !reflect.DeepEqual(neighbours, c.neighbours) {
+			t.Errorf("%s: %v, want %v", c.designator, neighbours, c.neighbours)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package UTM
+
+import "strconv"
+
+// Hemisphere selects the false northing of a UTM coordinate. It is a type of
+// its own because the hemisphere letters "N" and "S" are also latitude band
+// letters: band S lies between 32 deg N and 40 deg N.
+type Hemisphere int
+
+// Hemispheres. The zero value is not a valid hemisphere.
+const (
+	Northern Hemisphere = iota + 1
+	Southern
+)
+
+func (h Hemisphere) String() string {
+	switch h {
+	case Northern:
+		return "north"
+	case Southern:
+		return "south"
+	default:
+		return "Hemisphere(" + strconv.Itoa(int(h)) + ")"
+	}
+}
+
+// Valid reports whether h is Northern or Southern.
+func (h Hemisphere) Valid() bool {
+	return h == Northern || h == Southern
+}
+
+// HemisphereOf returns the hemisphere of a latitude. The equator belongs to
+// the northern hemisphere.
+func HemisphereOf(latitude float64) Hemisphere {
+	if latitude < 0 {
+		return Southern
+	}
+	return Northern
+}
+
+// BandHemisphere returns the hemisphere of a latitude band letter C to X.
+func BandHemisphere(band string) (Hemisphere, error) {
+	if band == "" {
+		return 0, letterError(band, 'C', 'X')
+	}
+
+	northern, err := parseHemisphere(band)
+	if err != nil {
+		return 0, err
+	}
+	if northern {
+		return Northern, nil
+	}
+	return Southern, nil
+}
+
+// FromLatLonHemisphere convert a latitude and longitude to Universal Transverse Mercator coordinates.
+// Unlike FromLatLon with northern set to true it reports the hemisphere as a
+// Hemisphere, which can't be mistaken for a latitude band.
+func FromLatLonHemisphere(latitude, longitude float64) (
+	easting, northing float64, zoneNumber int, hemisphere Hemisphere, err error,
+) {
+	return WGS84.FromLatLonHemisphere(latitude, longitude)
+}
+
+// FromLatLonHemisphere is FromLatLonHemisphere on the ellipsoid.
+func (el *Ellipsoid) FromLatLonHemisphere(latitude, longitude float64) (
+	easting, northing float64, zoneNumber int, hemisphere Hemisphere, err error,
+) {
+	easting, northing, zoneNumber, _, err = el.FromLatLon(latitude, longitude, false)
+	if err != nil {
+		return
+	}
+
+	hemisphere = HemisphereOf(latitude)
+
+	return
+}
+
+// ToLatLonHemisphere convert Universal Transverse Mercator coordinates to a latitude and longitude.
+// The hemisphere is given explicitly. To convert from a latitude band use
+// ToLatLon, which always reads its zone letter as a band.
+func ToLatLonHemisphere(easting, northing float64, zoneNumber int, hemisphere Hemisphere) (
+	latitude, longitude float64, err error,
+) {
+	return WGS84.ToLatLonHemisphere(easting, northing, zoneNumber, hemisphere)
+}
+
+// ToLatLonHemisphere is ToLatLonHemisphere on the ellipsoid.
+func (el *Ellipsoid) ToLatLonHemisphere(easting, northing float64, zoneNumber int, hemisphere Hemisphere) (
+	latitude, longitude float64, err error,
+) {
+	if !hemisphere.Valid() {
+		err = rangeError(ErrHemisphereOutOfRange, FieldHemisphere, float64(hemisphere), float64(Northern), float64(Southern))
+		return
+	}
+
+	return el.ToLatLon(easting, northing, zoneNumber, "", hemisphere == Northern)
+}
@@ -0,0 +1,98 @@
+package UTM_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestFromLatLonHemisphere(t *testing.T) {
+	t.Parallel()
+
+	for i, data := range getTestValues() {
+		easting, northing, zoneNumber, hemisphere, err := UTM.FromLatLonHemisphere(data.LatLon.Latitude, data.LatLon.Longitude)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if round(data.UTM.Easting) != round(easting) || round(data.UTM.Northing) != round(northing) {
+			t.Errorf("Easting/Northing FromLatLonHemisphere case %d", i)
+		}
+
+		if data.UTM.ZoneNumber != zoneNumber {
+			t.Errorf("ZoneNumber FromLatLonHemisphere case %d", i)
+		}
+
+		if want := UTM.HemisphereOf(data.LatLon.Latitude); hemisphere != want {
+			t.Errorf("Hemisphere FromLatLonHemisphere case %d: %s, want %s", i, hemisphere, want)
+		}
+
+		latitude, longitude, err := UTM.ToLatLonHemisphere(easting, northing, zoneNumber, hemisphere)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(latitude-data.LatLon.Latitude) > 1e-6 || math.Abs(longitude-data.LatLon.Longitude) > 1e-6 {
+			t.Errorf("round trip case %d: (%f, %f)", i, latitude, longitude)
+		}
+	}
+}
+
+// TestHemisphereBandCollision covers a southern point whose hemisphere
+// letter "S" would be read as the northern band S by ToLatLon.
+func TestHemisphereBandCollision(t *testing.T) {
+	t.Parallel()
+
+	// Capetown, South Africa
+	latitude, longitude := -33.92487, 18.42406
+
+	easting, northing, zoneNumber, hemisphere, err := UTM.FromLatLonHemisphere(latitude, longitude)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if hemisphere != UTM.Southern {
+		t.Fatalf("hemisphere %s", hemisphere)
+	}
+
+	lat, _, err := UTM.ToLatLonHemisphere(easting, northing, zoneNumber, hemisphere)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(lat-latitude) > 1e-6 {
+		t.Errorf("latitude %f, want %f", lat, latitude)
+	}
+
+	bandHemisphere, err := UTM.BandHemisphere("S")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if bandHemisphere != UTM.Northern {
+		t.Errorf("band S is in the %s hemisphere", bandHemisphere)
+	}
+}
+
+func TestHemisphereBadInput(t *testing.T) {
+	t.Parallel()
+
+	for _, hemisphere := range []UTM.Hemisphere{0, 3, -1} {
+		_, _, err := UTM.ToLatLonHemisphere(377486, 6296562, 30, hemisphere)
+		if !errors.Is(err, UTM.ErrHemisphereOutOfRange) {
+			t.Errorf("hemisphere %s: %v", hemisphere, err)
+		}
+	}
+
+	for _, band := range []string{"", "A", "Y", "I"} {
+		if _, err := UTM.BandHemisphere(band); !errors.Is(err, UTM.ErrZoneLetterOutOfRange) {
+			t.Errorf("band %q: %v", band, err)
+		}
+	}
+
+	if s := UTM.Hemisphere(0).String(); s != "Hemisphere(0)" {
+		t.Errorf("Hemisphere(0).String() = %q", s)
+	}
+}
@@ -0,0 +1,199 @@
+package UTM
+
+import "math"
+
+// jacobian returns the partial derivatives of forward at a latitude and a
+// longitude offset from the central meridian in radians. The longitude
+// derivatives differentiate the series term by term, the latitude derivatives
+// follow from them by the Cauchy–Riemann equations of the conformal projection
+// in isometric latitude, whose derivative is rho / (nu cos lat).
+func (s *snyder) jacobian(latRad, dLonRad float64) (dxdLat, dxdLon, dydLat, dydLon float64) {
+	latSin := math.Sin(latRad)
+	latCos := math.Cos(latRad)
+
+	latTan := latSin / latCos
+	latTan2 := latTan * latTan
+	latTan4 := latTan2 * latTan2
+
+	w := 1 - s.e*latSin*latSin
+	n := s.r / math.Sqrt(w)
+	rho := s.r * (1 - s.e) / (w * math.Sqrt(w))
+	c := s.eP2 * latCos * latCos
+
+	a := latCos * dLonRad
+	a2 := a * a
+	a3 := a2 * a
+	a4 := a3 * a
+	a5 := a4 * a
+
+	dxdLon = n * latCos * (1 +
+		a2/2*(1-latTan2+c) +
+		a4/24*(5-18*latTan2+latTan4+72*c-58*s.eP2))
+	dydLon = n * latTan * latCos * (a +
+		a3/6*(5-latTan2+9*c+4*c*c) +
+		a5/120*(61-58*latTan2+latTan4+600*c-330*s.eP2))
+
+	isometric := rho / (n * latCos)
+	dxdLat = -isometric * dydLon
+	dydLat = isometric * dxdLon
+
+	return
+}
+
+// Jacobian is the 2×2 matrix of partial derivatives of a conversion at a
+// point. Rows are the outputs and columns the inputs in the order the
+// conversion takes and returns them: for FromLatLonJacobian
+//
+//	| dEasting/dLatitude   dEasting/dLongitude  |
+//	| dNorthing/dLatitude  dNorthing/dLongitude |
+//
+// in metres per degree.
+type Jacobian [2][2]float64
+
+// Covariance is a symmetric 2×2 covariance matrix of two coordinates, in the
+// order of Jacobian. Geographic covariances are in square degrees, grid
+// covariances in square metres.
+type Covariance [2][2]float64
+
+// Inverse returns the inverse matrix, the Jacobian of the inverse conversion.
+func (j Jacobian) Inverse() Jacobian {
+	det := j[0][0]*j[1][1] - j[0][1]*j[1][0]
+
+	return Jacobian{
+		{j[1][1] / det, -j[0][1] / det},
+		{-j[1][0] / det, j[0][0] / det},
+	}
+}
+
+// Covariance propagates a covariance matrix of the inputs to the outputs to
+// first order: J C Jᵀ.
+func (j Jacobian) Covariance(c Covariance) (out Covariance) {
+	var jc [2][2]float64
+	for r := 0; r < 2; r++ {
+		for k := 0; k < 2; k++ {
+			jc[r][k] = j[r][0]*c[0][k] + j[r][1]*c[1][k]
+		}
+	}
+
+	for r := 0; r < 2; r++ {
+		for k := 0; k < 2; k++ {
+			out[r][k] = jc[r][0]*j[k][0] + jc[r][1]*j[k][1]
+		}
+	}
+
+	return
+}
+
+// FromLatLonJacobian returns the Jacobian of FromLatLon at a latitude and
+// longitude, in metres per degree, and the zone it refers to.
+func FromLatLonJacobian(latitude, longitude float64) (j Jacobian, zoneNumber int, err error) {
+	return WGS84.FromLatLonJacobian(latitude, longitude)
+}
+
+// FromLatLonJacobian is FromLatLonJacobian on the ellipsoid.
+func (el *Ellipsoid) FromLatLonJacobian(latitude, longitude float64) (j Jacobian, zoneNumber int, err error) {
+	longitude, zoneNumber, _, err = parseFromLatLonInput(latitude, longitude, false)
+	if err != nil {
+		return
+	}
+
+	j = el.jacobian(latitude, longitude, zoneNumber)
+
+	return
+}
+
+// ToLatLonJacobian returns the Jacobian of ToLatLon at Universal Transverse
+// Mercator coordinates, in degrees per metre:
+//
+//	| dLatitude/dEasting   dLatitude/dNorthing  |
+//	| dLongitude/dEasting  dLongitude/dNorthing |
+//
+// It is the inverse of FromLatLonJacobian at the same point.
+func ToLatLonJacobian(easting, northing float64, zoneNumber int, hemisphere Hemisphere) (j Jacobian, err error) {
+	return WGS84.ToLatLonJacobian(easting, northing, zoneNumber, hemisphere)
+}
+
+// ToLatLonJacobian is ToLatLonJacobian on the ellipsoid.
+func (el *Ellipsoid) ToLatLonJacobian(easting, northing float64, zoneNumber int, hemisphere Hemisphere) (j Jacobian, err error) {
+	latitude, longitude, err := el.ToLatLonHemisphere(easting, northing, zoneNumber, hemisphere)
+	if err != nil {
+		return
+	}
+
+	j = el.jacobian(latitude, longitude, zoneNumber).Inverse()
+
+	return
+}
+
+// FromLatLonCovariance converts a latitude and longitude covariance in square
+// degrees to an easting and northing covariance in square metres at the point.
+func FromLatLonCovariance(latitude, longitude float64, c Covariance) (Covariance, error) {
+	return WGS84.FromLatLonCovariance(latitude, longitude, c)
+}
+
+// FromLatLonCovariance is FromLatLonCovariance on the ellipsoid.
+func (el *Ellipsoid) FromLatLonCovariance(latitude, longitude float64, c Covariance) (Covariance, error) {
+	j, _, err := el.FromLatLonJacobian(latitude, longitude)
+	if err != nil {
+		return Covariance{}, err
+	}
+
+	return j.Covariance(c), nil
+}
+
+// ToLatLonCovariance converts an easting and northing covariance in square
+// metres to a latitude and longitude covariance in square degrees at the point.
+func ToLatLonCovariance(easting, northing float64, zoneNumber int, hemisphere Hemisphere, c Covariance) (Covariance, error) {
+	return WGS84.ToLatLonCovariance(easting, northing, zoneNumber, hemisphere, c)
+}
+
+// ToLatLonCovariance is ToLatLonCovariance on the ellipsoid.
+func (el *Ellipsoid) ToLatLonCovariance(easting, northing float64, zoneNumber int, hemisphere Hemisphere, c Covariance) (Covariance, error) {
+	j, err := el.ToLatLonJacobian(easting, northing, zoneNumber, hemisphere)
+	if err != nil {
+		return Covariance{}, err
+	}
+
+	return j.Covariance(c), nil
+}
+
+// ENUJacobian returns the Jacobian from local east and north metres, as in an
+// ENU frame at the point, to easting and northing:
+//
+//	| dEasting/dEast   dEasting/dNorth  |
+//	| dNorthing/dEast  dNorthing/dNorth |
+//
+// It is the rotation by the meridian convergence scaled by the point scale
+// factor, see FromLatLonWithConvergence.
+func ENUJacobian(latitude, longitude float64) (Jacobian, error) {
+	return WGS84.ENUJacobian(latitude, longitude)
+}
+
+// ENUJacobian is ENUJacobian on the ellipsoid.
+func (el *Ellipsoid) ENUJacobian(latitude, longitude float64) (Jacobian, error) {
+	longitude, zoneNumber, _, err := parseFromLatLonInput(latitude, longitude, false)
+	if err != nil {
+		return Jacobian{}, err
+	}
+
+	convergence, scale := el.convergenceScale(latitude, longitude, zoneNumber)
+	sin, cos := math.Sincos(rad(convergence))
+
+	return Jacobian{
+		{scale * cos, -scale * sin},
+		{scale * sin, scale * cos},
+	}, nil
+}
+
+// jacobian returns the Jacobian of the UTM projection in metres per degree
+// of a point in the given zone.
+func (el *Ellipsoid) jacobian(latitude, longitude float64, zoneNumber int) Jacobian {
+	dLon := normalizeLongitude(longitude - float64(zoneNumberToCentralLongitude(zoneNumber)))
+
+	dxdLat, dxdLon, dydLat, dydLon := el.snyder.jacobian(rad(latitude), rad(dLon))
+
+	return Jacobian{
+		{k0 * xr * dxdLat, k0 * xr * dxdLon},
+		{k0 * xr * dydLat, k0 * xr * dydLon},
+	}
+}
@@ -0,0 +1,172 @@
+package UTM_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+// numericJacobian differentiates FromLatLon by central differences.
+func numericJacobian(t *testing.T, latitude, longitude float64) UTM.Jacobian {
+	const h = 1e-5
+
+	at := func(latitude, longitude float64) (float64, float64) {
+		easting, northing, _, _, err := UTM.FromLatLon(latitude, longitude, false)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return easting, northing
+	}
+
+	e1, n1 := at(latitude+h, longitude)
+	e0, n0 := at(latitude-h, longitude)
+	e3, n3 := at(latitude, longitude+h)
+	e2, n2 := at(latitude, longitude-h)
+
+	return UTM.Jacobian{
+		{(e1 - e0) / (2 * h), (e3 - e2) / (2 * h)},
+		{(n1 - n0) / (2 * h), (n3 - n2) / (2 * h)},
+	}
+}
+
+func TestFromLatLonJacobian(t *testing.T) {
+	t.Parallel()
+
+	for _, p := range []testLatLon{{0.5, 3}, {50.77535, 6.08389}, {-33.9, 18.42}, {60, 5.5}, {83, 40}, {-79, -175}} {
+		j, _, err := UTM.FromLatLonJacobian(p.Latitude, p.Longitude)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		want := numericJacobian(t, p.Latitude, p.Longitude)
+
+		for r := 0; r < 2; r++ {
+			for c := 0; c < 2; c++ {
+				// the series is conformal to its truncation order, about a
+				// millimetre per 100 km
+				if math.Abs(j[r][c]-want[r][c]) > 1e-6*111000 {
+					t.Errorf("%v: J[%d][%d] = %f, want %f", p, r, c, j[r][c], want[r][c])
+				}
+			}
+		}
+	}
+
+	if _, _, err := UTM.FromLatLonJacobian(85, 0); !errors.Is(err, UTM.ErrLatitudeOutOfRange) {
+		t.Errorf("%v is not %v", err, UTM.ErrLatitudeOutOfRange)
+	}
+}
+
+func TestToLatLonJacobian(t *testing.T) {
+	t.Parallel()
+
+	easting, northing, zoneNumber, hemisphere, err := UTM.FromLatLonHemisphere(-33.9, 18.42)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	forward, _, err := UTM.FromLatLonJacobian(-33.9, 18.42)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	inverse, err := UTM.ToLatLonJacobian(easting, northing, zoneNumber, hemisphere)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// the product is the identity
+	for r := 0; r < 2; r++ {
+		for c := 0; c < 2; c++ {
+			product := forward[r][0]*inverse[0][c] + forward[r][1]*inverse[1][c]
+			want := 0.0
+			if r == c {
+				want = 1
+			}
+			if math.Abs(product-want) > 1e-6 {
+				t.Errorf("(J J⁻¹)[%d][%d] = %g", r, c, product)
+			}
+		}
+	}
+
+	// moving a metre north changes the latitude by about 1/111 km
+	if math.Abs(inverse[0][1]-1/110900.0) > 1e-8 {
+		t.Errorf("dLatitude/dNorthing = %g", inverse[0][1])
+	}
+
+	if _, err := UTM.ToLatLonJacobian(easting, northing, 61, hemisphere); !errors.Is(err, UTM.ErrZoneNumberOutOfRange) {
+		t.Errorf("%v is not %v", err, UTM.ErrZoneNumberOutOfRange)
+	}
+}
+
+func TestCovariance(t *testing.T) {
+	t.Parallel()
+
+	// one metre standard deviation north and two east as degrees at 50 N
+	sin, cos := math.Sincos(50 * math.Pi / 180)
+	e2 := UTM.WGS84.EccentricitySquared()
+	w := 1 - e2*sin*sin
+	nu := UTM.WGS84.SemiMajorAxis() / math.Sqrt(w)
+	rho := nu * (1 - e2) / w
+
+	north := 180 / (math.Pi * rho)
+	east := 2 * 180 / (math.Pi * nu * cos)
+	geographic := UTM.Covariance{
+		{north * north, 0},
+		{0, east * east},
+	}
+
+	grid, err := UTM.FromLatLonCovariance(50, 9, geographic)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// on the central meridian only the scale factor 0.9996 applies
+	if math.Abs(grid[0][0]-4*0.9996*0.9996) > 1e-6 || math.Abs(grid[1][1]-0.9996*0.9996) > 1e-6 ||
+		math.Abs(grid[0][1]) > 1e-9 || grid[0][1] != grid[1][0] {
+		t.Errorf("grid covariance %v", grid)
+	}
+
+	easting, northing, zoneNumber, hemisphere, err := UTM.FromLatLonHemisphere(50, 9)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	back, err := UTM.ToLatLonCovariance(easting, northing, zoneNumber, hemisphere, grid)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for r := 0; r < 2; r++ {
+		for c := 0; c < 2; c++ {
+			if math.Abs(back[r][c]-geographic[r][c]) > 1e-6*geographic[1][1] {
+				t.Errorf("C[%d][%d] = %g, want %g", r, c, back[r][c], geographic[r][c])
+			}
+		}
+	}
+}
+
+func TestENUJacobian(t *testing.T) {
+	t.Parallel()
+
+	latitude, longitude := 47.0, 14.5
+
+	j, err := UTM.ENUJacobian(latitude, longitude)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// a metre east and north in an ENU frame at the point, through FromLatLon
+	frame := UTM.ENU{Latitude: latitude, Longitude: longitude}
+	easting0, northing0, _, _, _ := UTM.FromLatLon(latitude, longitude, false)
+
+	for c, step := range [][2]float64{{1, 0}, {0, 1}} {
+		lat, lon, _ := frame.ToLatLon(step[0], step[1], 0)
+		easting, northing, _, _, _ := UTM.FromLatLon(lat, lon, false)
+
+		if math.Abs(easting-easting0-j[0][c]) > 1e-5 || math.Abs(northing-northing0-j[1][c]) > 1e-5 {
+			t.Errorf("column %d: (%f, %f), want (%f, %f)", c, j[0][c], j[1][c], easting-easting0, northing-northing0)
+		}
+	}
+}
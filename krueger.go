@@ -0,0 +1,177 @@
+package UTM
+
+import "math"
+
+// wgs84InverseFlattening is the defining inverse flattening of WGS84.
+const wgs84InverseFlattening = 298.257223563
+
+// krueger holds the coefficients of the Krüger series in the third
+// flattening n, truncated after the n^6 terms as in GeographicLib.
+type krueger struct {
+	a     float64    // semi-major axis
+	e     float64    // first eccentricity
+	e2    float64    // first eccentricity squared
+	bigA  float64    // radius of the rectifying sphere
+	alpha [6]float64 // forward series, alpha[j-1] is α_j
+	beta  [6]float64 // inverse series, beta[j-1] is β_j
+}
+
+func newKrueger(a, f float64) krueger {
+	n := f / (2 - f)
+	n2 := n * n
+	n3 := n2 * n
+	n4 := n3 * n
+	n5 := n4 * n
+	n6 := n5 * n
+
+	k := krueger{
+		a:    a,
+		e2:   f * (2 - f),
+		bigA: a / (1 + n) * (1 + n2/4 + n4/64 + n6/256),
+	}
+	k.e = math.Sqrt(k.e2)
+
+	k.alpha = [6]float64{
+		n/2 - 2*n2/3 + 5*n3/16 + 41*n4/180 - 127*n5/288 + 7891*n6/37800,
+		13*n2/48 - 3*n3/5 + 557*n4/1440 + 281*n5/630 - 1983433*n6/1935360,
+		61*n3/240 - 103*n4/140 + 15061*n5/26880 + 167603*n6/181440,
+		49561*n4/161280 - 179*n5/168 + 6601661*n6/7257600,
+		34729*n5/80640 - 3418889*n6/1995840,
+		212378941 * n6 / 319334400,
+	}
+
+	k.beta = [6]float64{
+		n/2 - 2*n2/3 + 37*n3/96 - n4/360 - 81*n5/512 + 96199*n6/604800,
+		n2/48 + n3/15 - 437*n4/1440 + 46*n5/105 - 1118711*n6/3870720,
+		17*n3/480 - 37*n4/840 - 209*n5/4480 + 5569*n6/90720,
+		4397*n4/161280 - 11*n5/504 - 830251*n6/7257600,
+		4583*n5/161280 - 108847*n6/3991680,
+		20648693 * n6 / 638668800,
+	}
+
+	return k
+}
+
+// conformalTan returns the tangent of the conformal latitude for tau = tan(latitude).
+func (k *krueger) conformalTan(tau float64) float64 {
+	sigma := math.Sinh(k.e * math.Atanh(k.e*tau/math.Sqrt(1+tau*tau)))
+	return tau*math.Sqrt(1+sigma*sigma) - sigma*math.Sqrt(1+tau*tau)
+}
+
+// forward projects a latitude and a longitude offset from the central
+// meridian (both in radians) onto the transverse Mercator plane with unit
+// scale on the central meridian.
+func (k *krueger) forward(latRad, dLonRad float64) (x, y float64) {
+	tauP := k.conformalTan(math.Tan(latRad))
+	lonCos := math.Cos(dLonRad)
+
+	xiP := math.Atan2(tauP, lonCos)
+	etaP := math.Asinh(math.Sin(dLonRad) / math.Sqrt(tauP*tauP+lonCos*lonCos))
+
+	xi, eta := xiP, etaP
+	for j, alpha := range k.alpha {
+		j2 := float64(2 * (j + 1))
+		xi += alpha * math.Sin(j2*xiP) * math.Cosh(j2*etaP)
+		eta += alpha * math.Cos(j2*xiP) * math.Sinh(j2*etaP)
+	}
+
+	return k.bigA * eta, k.bigA * xi
+}
+
+// inverse is the inverse of forward. It returns the latitude and the
+// longitude offset from the central meridian in radians.
+func (k *krueger) inverse(x, y float64) (latRad, dLonRad float64) {
+	eta := x / k.bigA
+	xi := y / k.bigA
+
+	xiP, etaP := xi, eta
+	for j, beta := range k.beta {
+		j2 := float64(2 * (j + 1))
+		xiP -= beta * math.Sin(j2*xi) * math.Cosh(j2*eta)
+		etaP -= beta * math.Cos(j2*xi) * math.Sinh(j2*eta)
+	}
+
+	sinhEtaP := math.Sinh(etaP)
+	sinXiP := math.Sin(xiP)
+	cosXiP := math.Cos(xiP)
+
+	tauP := sinXiP / math.Sqrt(sinhEtaP*sinhEtaP+cosXiP*cosXiP)
+
+	// Newton's method on tau' = f(tau), converges in two or three steps.
+	tau := tauP
+	for i := 0; i < 10; i++ {
+		tauI := k.conformalTan(tau)
+		delta := (tauP - tauI) / math.Sqrt(1+tauI*tauI) *
+			(1 + (1-k.e2)*tau*tau) / ((1 - k.e2) * math.Sqrt(1+tau*tau))
+		tau += delta
+		if math.Abs(delta) < 1e-12 {
+			break
+		}
+	}
+
+	return math.Atan(tau), math.Atan2(sinhEtaP, cosXiP)
+}
+
+// FromLatLonKrueger convert a latitude and longitude to Universal Transverse Mercator coordinates.
+// It has the same contract as FromLatLon but uses the Krüger series in the
+// third flattening. Projecting a point and converting it back with
+// ToLatLonKrueger or TransverseMercator lands within 10 nanometres of it up
+// to 4000 km from the central meridian. FromLatLon is faster and agrees with
+// FromLatLonKrueger to a few millimetres inside the standard zones.
+func FromLatLonKrueger(latitude, longitude float64, northern bool) (
+	easting, northing float64, zoneNumber int, zoneLetter string, err error,
+) {
+	return WGS84.FromLatLonKrueger(latitude, longitude, northern)
+}
+
+// FromLatLonKrueger is FromLatLonKrueger on the ellipsoid.
+func (el *Ellipsoid) FromLatLonKrueger(latitude, longitude float64, northern bool) (
+	easting, northing float64, zoneNumber int, zoneLetter string, err error,
+) {
+	longitude, zoneNumber, zoneLetter, err = parseFromLatLonInput(latitude, longitude, northern)
+	if err != nil {
+		return
+	}
+
+	tm := el.utm(zoneNumber, latitude >= 0)
+
+	easting, northing = tm.forward(rad(latitude), rad(longitude-tm.CentralMeridian))
+
+	return
+}
+
+// ToLatLonKrueger convert Universal Transverse Mercator coordinates to a latitude and longitude.
+// It has the same contract as ToLatLon but uses the Krüger series in the
+// third flattening. See FromLatLonKrueger.
+func ToLatLonKrueger(
+	easting, northing float64,
+	zoneNumber int,
+	zoneLetter string,
+	northern ...bool) (
+	latitude, longitude float64, err error,
+) {
+	return WGS84.ToLatLonKrueger(easting, northing, zoneNumber, zoneLetter, northern...)
+}
+
+// ToLatLonKrueger is ToLatLonKrueger on the ellipsoid.
+func (el *Ellipsoid) ToLatLonKrueger(
+	easting, northing float64,
+	zoneNumber int,
+	zoneLetter string,
+	northern ...bool) (
+	latitude, longitude float64, err error,
+) {
+	northernValue, err := parseToLatLonInput(easting, northing, zoneNumber, zoneLetter, northern...)
+	if err != nil {
+		return
+	}
+
+	tm := el.utm(zoneNumber, northernValue)
+
+	latRad, dLonRad := tm.inverse(easting, northing)
+
+	latitude = deg(latRad)
+	longitude = deg(dLonRad) + tm.CentralMeridian
+
+	return
+}
@@ -0,0 +1,160 @@
+package UTM_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestFromLatLonKrueger(t *testing.T) {
+	t.Parallel()
+
+	for i, data := range getTestValues() {
+		easting, northing, zoneNumber, zoneLetter, err := UTM.FromLatLonKrueger(data.LatLon.Latitude, data.LatLon.Longitude, false)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if round(data.UTM.Easting) != round(easting) {
+			t.Errorf("Easting FromLatLonKrueger case %d", i)
+		}
+
+		if round(data.UTM.Northing) != round(northing) {
+			t.Errorf("Northing FromLatLonKrueger case %d", i)
+		}
+
+		if data.UTM.ZoneLetter != zoneLetter {
+			t.Errorf("ZoneLetter FromLatLonKrueger case %d", i)
+		}
+
+		if data.UTM.ZoneNumber != zoneNumber {
+			t.Errorf("ZoneNumber FromLatLonKrueger case %d", i)
+		}
+	}
+}
+
+func TestToLatLonKrueger(t *testing.T) {
+	t.Parallel()
+
+	for i, data := range getTestValues() {
+		latitude, longitude, err := UTM.ToLatLonKrueger(
+			data.UTM.Easting,
+			data.UTM.Northing,
+			data.UTM.ZoneNumber,
+			data.UTM.ZoneLetter)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if round(data.LatLon.Latitude) != round(latitude) {
+			t.Errorf("Latitude ToLatLonKrueger case %d", i)
+		}
+
+		if round(data.LatLon.Longitude) != round(longitude) {
+			t.Errorf("Longitude ToLatLonKrueger case %d", i)
+		}
+	}
+}
+
+func TestToLatLonKruegerBadInput(t *testing.T) {
+	t.Parallel()
+
+	for i, data := range getBadInputToLatLon() {
+		_, _, err := UTM.ToLatLonKrueger(data.Easting, data.Northing, data.ZoneNumber, data.ZoneLetter)
+		if err == nil {
+			t.Errorf("Expected error. badInputToLatLon TestToLatLonKruegerBadInput case %d", i)
+		}
+		if _, ok := err.(UTM.InputError); !ok {
+			t.Error("Type of error must be UTM.InputError.")
+		}
+	}
+}
+
+// TestKruegerRoundTrip checks that forward and inverse agree to well below a
+// micrometre across all zones, including the widened Norway/Svalbard zones.
+func TestKruegerRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	const tolerance = 1e-11 // degrees, about a micrometre
+
+	for latitude := -80.0; latitude <= 84; latitude += 0.75 {
+		for longitude := -180.0; longitude < 180; longitude += 1.25 {
+			easting, northing, zoneNumber, zoneLetter, err := UTM.FromLatLonKrueger(latitude, longitude, false)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			lat, lon, err := UTM.ToLatLonKrueger(easting, northing, zoneNumber, zoneLetter)
+			if err != nil {
+				t.Fatalf("(%f, %f): %s", latitude, longitude, err)
+			}
+
+			if math.Abs(lat-latitude) > tolerance || math.Abs(lon-longitude) > tolerance {
+				t.Errorf("round trip (%f, %f) -> (%.12f, %.12f)", latitude, longitude, lat, lon)
+			}
+		}
+	}
+}
+
+// TestKruegerRoundTripFarFromMeridian checks the round trip accuracy claimed
+// for FromLatLonKrueger out to 4000 km from the central meridian, far outside
+// the zone, through the zone's TransverseMercator.
+func TestKruegerRoundTripFarFromMeridian(t *testing.T) {
+	t.Parallel()
+
+	const tolerance = 1e-8 // metres
+
+	tm, err := UTM.UTMZone(31, UTM.Northern)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for latitude := -85.0; latitude <= 85; latitude += 0.5 {
+		for longitude := 3.0; longitude < 83; longitude += 0.25 {
+			easting, northing, err := tm.FromLatLon(latitude, longitude)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			if math.Abs(easting-500000) > 0.9996*4000000 {
+				continue
+			}
+
+			lat, lon, err := tm.ToLatLon(easting, northing)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			// degrees to metres on a sphere is good enough for an error bound
+			d := math.Hypot(lat-latitude, (lon-longitude)*math.Cos(latitude*math.Pi/180)) * 111320
+			if d > tolerance {
+				t.Errorf("round trip (%f, %f) at easting %f is off by %g m", latitude, longitude, easting, d)
+			}
+		}
+	}
+}
+
+// TestKruegerAgreesWithSnyder checks that inside a standard zone both series
+// agree to the millimetre level the truncated series is good for.
+func TestKruegerAgreesWithSnyder(t *testing.T) {
+	t.Parallel()
+
+	for latitude := -80.0; latitude <= 84; latitude++ {
+		for longitude := 0.0; longitude < 6; longitude += 0.25 {
+			e1, n1, _, _, err := UTM.FromLatLon(latitude, longitude, false)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			e2, n2, _, _, err := UTM.FromLatLonKrueger(latitude, longitude, false)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			if d := math.Hypot(e1-e2, n1-n2); d > 0.005 {
+				t.Errorf("(%f, %f): series differ by %f m", latitude, longitude, d)
+			}
+		}
+	}
+}
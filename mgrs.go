@@ -0,0 +1,211 @@
+package UTM
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Letters of the 100 km square identifiers. The column letters repeat every
+// three zones, the row letters every 2000 km with an offset of five letters
+// in even numbered zones.
+var mgrsColumnLetters = [3]string{"ABCDEFGH", "JKLMNPQR", "STUVWXYZ"}
+
+const mgrsRowLetters = "ABCDEFGHJKLMNPQRSTUV"
+
+// ToMGRS convert a latitude and longitude to a Military Grid Reference System string such as "33UXP0414510012".
+// precision is the number of digits of easting and northing, from 1 (10 km)
+// down to 5 (1 m), 0 gives just the 100 km square. Coordinates are truncated,
+// not rounded, so the reference names the square containing the point.
+// The polar caps, which use UPS based references, are not supported.
+func ToMGRS(latitude, longitude float64, precision int) (string, error) {
+	return WGS84.ToMGRS(latitude, longitude, precision)
+}
+
+// ToMGRS is ToMGRS on the ellipsoid.
+func (el *Ellipsoid) ToMGRS(latitude, longitude float64, precision int) (string, error) {
+	easting, northing, zoneNumber, zoneLetter, err := el.FromLatLon(latitude, longitude, false)
+	if err != nil {
+		return "", err
+	}
+
+	return UTMToMGRS(easting, northing, zoneNumber, zoneLetter, precision)
+}
+
+// UTMToMGRS convert Universal Transverse Mercator coordinates to a Military Grid Reference System string.
+// The zone letter must be the latitude band as returned by FromLatLon with
+// northern set to false. See ToMGRS for precision.
+func UTMToMGRS(easting, northing float64, zoneNumber int, zoneLetter string, precision int) (string, error) {
+	if !(0 <= precision && precision <= 5) {
+		return "", rangeError(ErrPrecisionOutOfRange, FieldPrecision, float64(precision), 0, 5)
+	}
+
+	if !(1 <= zoneNumber && zoneNumber <= 60) {
+		return "", rangeError(ErrZoneNumberOutOfRange, FieldZoneNumber, float64(zoneNumber), 1, 60)
+	}
+
+	band, ok := mgrsBand(zoneLetter)
+	if !ok {
+		return "", letterError(zoneLetter, 'C', 'X')
+	}
+
+	if !(0 <= northing && northing < 10000000) {
+		return "", rangeError(ErrNorthingOutOfRange, FieldNorthing, northing, 0, 10000000)
+	}
+
+	column := int(math.Floor(easting / 100000))
+	if !(1 <= column && column <= 8) {
+		return "", rangeError(ErrEastingOutOfRange, FieldEasting, easting, 100000, 900000)
+	}
+
+	set := (zoneNumber - 1) % 6
+
+	row := int(math.Floor(northing/100000)) % 20
+	if set%2 == 1 {
+		row = (row + 5) % 20
+	}
+
+	scale := math.Pow10(5 - precision)
+	e := int(math.Floor(math.Mod(easting, 100000) / scale))
+	n := int(math.Floor(math.Mod(northing, 100000) / scale))
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%d%c%c%c", zoneNumber, band,
+		mgrsColumnLetters[set%3][column-1], mgrsRowLetters[row])
+	if precision > 0 {
+		fmt.Fprintf(&b, "%0*d%0*d", precision, e, precision, n)
+	}
+
+	return b.String(), nil
+}
+
+// FromMGRS convert a Military Grid Reference System string to a latitude and longitude.
+// Spaces and lower case letters are accepted. The result is the south-west
+// corner of the square named by the reference.
+func FromMGRS(mgrs string) (latitude, longitude float64, err error) {
+	return WGS84.FromMGRS(mgrs)
+}
+
+// FromMGRS is FromMGRS on the ellipsoid.
+func (el *Ellipsoid) FromMGRS(mgrs string) (latitude, longitude float64, err error) {
+	easting, northing, zoneNumber, zoneLetter, err := el.MGRSToUTM(mgrs)
+	if err != nil {
+		return
+	}
+
+	return el.ToLatLon(easting, northing, zoneNumber, zoneLetter)
+}
+
+// MGRSToUTM convert a Military Grid Reference System string to Universal Transverse Mercator coordinates.
+// The zone letter is the latitude band. See FromMGRS.
+func MGRSToUTM(mgrs string) (easting, northing float64, zoneNumber int, zoneLetter string, err error) {
+	return WGS84.MGRSToUTM(mgrs)
+}
+
+// MGRSToUTM is MGRSToUTM on the ellipsoid.
+func (el *Ellipsoid) MGRSToUTM(mgrs string) (easting, northing float64, zoneNumber int, zoneLetter string, err error) {
+	s := strings.ToUpper(strings.Join(strings.Fields(mgrs), ""))
+
+	digits := 0
+	for digits < len(s) && digits < 2 && '0' <= s[digits] && s[digits] <= '9' {
+		digits++
+	}
+
+	if digits == 0 || len(s) < digits+3 {
+		err = inputError(ErrInvalidMGRS, FieldNone, strconv.Quote(mgrs))
+		return
+	}
+
+	zoneNumber, _ = strconv.Atoi(s[:digits])
+	if !(1 <= zoneNumber && zoneNumber <= 60) {
+		err = rangeError(ErrZoneNumberOutOfRange, FieldZoneNumber, float64(zoneNumber), 1, 60)
+		return
+	}
+
+	band, ok := mgrsBand(s[digits : digits+1])
+	if !ok {
+		err = letterError(s[digits:digits+1], 'C', 'X')
+		return
+	}
+	zoneLetter = string(band)
+
+	set := (zoneNumber - 1) % 6
+
+	column := strings.IndexByte(mgrsColumnLetters[set%3], s[digits+1])
+	row := strings.IndexByte(mgrsRowLetters, s[digits+2])
+
+	if column < 0 || row < 0 {
+		err = inputError(ErrInvalidMGRS, FieldNone, fmt.Sprintf("100 km square %q in zone %d", s[digits+1:digits+3], zoneNumber))
+		return
+	}
+
+	if set%2 == 1 {
+		row = (row + 15) % 20
+	}
+
+	numbers := s[digits+3:]
+	precision := len(numbers) / 2
+
+	if len(numbers)%2 != 0 || precision > 5 || strings.Trim(numbers, "0123456789") != "" {
+		err = inputError(ErrInvalidMGRS, FieldNone, fmt.Sprintf("coordinates %q", numbers))
+		return
+	}
+
+	scale := math.Pow10(5 - precision)
+
+	var e, n int
+	if precision > 0 {
+		e, _ = strconv.Atoi(numbers[:precision])
+		n, _ = strconv.Atoi(numbers[precision:])
+	}
+
+	easting = float64(column+1)*100000 + float64(e)*scale
+
+	// The row letters repeat every 2000 km, the band decides which cycle.
+	minNorthing := el.bandMinNorthing(zoneNumber, band)
+	northing = float64(row)*100000 + float64(n)*scale
+	for northing < math.Floor(minNorthing/100000)*100000 {
+		northing += 2000000
+	}
+
+	return
+}
+
+// bandMinNorthing returns the lowest northing of the southern edge of a grid
+// zone. The parallel curves towards the pole, so in the north the edge is
+// lowest on the central meridian and in the south at the sides of the zone.
+func (el *Ellipsoid) bandMinNorthing(zoneNumber int, band byte) float64 {
+	z := GridZone{zoneNumber, band}
+	latitude, _, west, east := z.Bounds()
+	centralLon := z.CentralMeridian()
+
+	minY := math.Inf(1)
+	for _, dLon := range []float64{0, west - centralLon, east - centralLon} {
+		if _, y := el.snyder.forward(rad(latitude), rad(dLon)); y < minY {
+			minY = y
+		}
+	}
+
+	northing := k0 * minY
+	if latitude < 0 {
+		northing += 10000000
+	}
+
+	return northing
+}
+
+// mgrsBand validates a latitude band letter and returns it upper cased.
+func mgrsBand(zoneLetter string) (byte, bool) {
+	if len(zoneLetter) != 1 {
+		return 0, false
+	}
+
+	band := strings.ToUpper(zoneLetter)[0]
+	if !('C' <= band && band <= 'X') || band == 'I' || band == 'O' {
+		return 0, false
+	}
+
+	return band, true
+}
@@ -0,0 +1,196 @@
+package UTM_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestToMGRS(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		latitude, longitude float64
+		precision           int
+		mgrs                string
+	}{
+		// reference value of the Python mgrs package and its truncations
+		{42, -93, 5, "15TWG0000049776"},
+		{42, -93, 4, "15TWG00004977"},
+		{42, -93, 1, "15TWG04"},
+		{42, -93, 0, "15TWG"},
+		// Washington Monument, 18S UJ 23480 06470 in the MGRS literature
+		{38.88949, -77.03528, 4, "18SUJ23470648"},
+		// Aachen, Germany
+		{50.77535, 6.08389, 3, "32UKB944288"},
+		// Wellington, New Zealand, an even zone south of the equator
+		{-41.28646, 174.77624, 5, "60GUV1378427057"},
+		// Norway zone 32V
+		{60, 5, 2, "32VKM7658"},
+		// Svalbard zone 33X
+		{78.2, 15.6, 5, "33XWG1369680760"},
+	}
+
+	for i, c := range cases {
+		mgrs, err := UTM.ToMGRS(c.latitude, c.longitude, c.precision)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if mgrs != c.mgrs {
+			t.Errorf("ToMGRS case %d: %s, want %s", i, mgrs, c.mgrs)
+		}
+	}
+}
+
+func TestFromMGRS(t *testing.T) {
+	t.Parallel()
+
+	latitude, longitude, err := UTM.FromMGRS("15TWG0000049776")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(latitude-41.999997974) > 1e-6 || math.Abs(longitude+93) > 1e-6 {
+		t.Errorf("FromMGRS (%f, %f)", latitude, longitude)
+	}
+
+	// spaces, lower case and a leading zero are accepted
+	easting, northing, zoneNumber, zoneLetter, err := UTM.MGRSToUTM("04q fj 1234 5678")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if easting != 612340 || northing != 2356780 || zoneNumber != 4 || zoneLetter != "Q" {
+		t.Errorf("MGRSToUTM (%f, %f, %d, %s)", easting, northing, zoneNumber, zoneLetter)
+	}
+}
+
+// TestMGRSRoundTrip checks that truncation puts the point into the south-west
+// corner of its square and that the band resolves the 2000 km row cycle.
+func TestMGRSRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for latitude := -80.0; latitude <= 84; latitude += 0.7 {
+		for longitude := -180.0; longitude < 180; longitude += 4.3 {
+			easting, northing, zoneNumber, zoneLetter, err := UTM.FromLatLon(latitude, longitude, false)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			mgrs, err := UTM.UTMToMGRS(easting, northing, zoneNumber, zoneLetter, 5)
+			if err != nil {
+				t.Fatalf("(%f, %f): %s", latitude, longitude, err)
+			}
+
+			e, n, z, l, err := UTM.MGRSToUTM(mgrs)
+			if err != nil {
+				t.Fatalf("%s: %s", mgrs, err)
+			}
+
+			if z != zoneNumber || l != zoneLetter {
+				t.Errorf("%s: zone %d%s, want %d%s", mgrs, z, l, zoneNumber, zoneLetter)
+			}
+
+			if !(0 <= easting-e && easting-e < 1 && 0 <= northing-n && northing-n < 1) {
+				t.Errorf("%s: (%f, %f), want (%f, %f)", mgrs, e, n, easting, northing)
+			}
+		}
+	}
+}
+
+// TestMGRSBandEdges decodes references at the southern edge of every grid
+// zone at both sides of the zone, where parallels in the south run lowest.
+func TestMGRSBandEdges(t *testing.T) {
+	t.Parallel()
+
+	easting, northing, zoneNumber, zoneLetter, err := UTM.MGRSToUTM("1ECJ5330499532")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if easting != 353304 || northing != 2899532 || zoneNumber != 1 || zoneLetter != "E" {
+		t.Errorf("MGRSToUTM (%f, %f, %d, %s)", easting, northing, zoneNumber, zoneLetter)
+	}
+
+	const inside = 1e-6 // degrees
+
+	for _, z := range UTM.GridZones() {
+		south, _, west, east := z.Bounds()
+
+		for _, longitude := range []float64{west + inside, east - inside} {
+			easting, northing, zoneNumber, zoneLetter, err := UTM.FromLatLon(south+inside, longitude, false)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			mgrs, err := UTM.UTMToMGRS(easting, northing, zoneNumber, zoneLetter, 5)
+			if err != nil {
+				t.Fatalf("%s (%f, %f): %s", z, south, longitude, err)
+			}
+
+			_, n, _, _, err := UTM.MGRSToUTM(mgrs)
+			if err != nil {
+				t.Fatalf("%s: %s", mgrs, err)
+			}
+
+			if !(0 <= northing-n && northing-n < 1) {
+				t.Errorf("%s at (%f, %f): northing %f, want %f", mgrs, south, longitude, n, northing)
+			}
+		}
+	}
+}
+
+func TestMGRSBadInput(t *testing.T) {
+	t.Parallel()
+
+	for i, mgrs := range []string{
+		"",
+		"15",
+		"15T",
+		"15TW",
+		"0TWG",
+		"61TWG",
+		"15AWG",
+		"15IWG",
+		// column letters of zone 15 are S to Z
+		"15TAG",
+		"15TWW",
+		"15TWG123",
+		"15TWG123456789012",
+		"15TWG12a4",
+		"123TWG",
+	} {
+		_, _, _, _, err := UTM.MGRSToUTM(mgrs)
+		if err == nil {
+			t.Errorf("Expected error. TestMGRSBadInput case %d %q", i, mgrs)
+		}
+		if _, ok := err.(UTM.InputError); !ok {
+			t.Error("Type of error must be UTM.InputError.")
+		}
+	}
+
+	for i, data := range []struct {
+		testCoordinate
+		precision int
+	}{
+		{testCoordinate{500000, 4649776, 15, "T"}, 6},
+		{testCoordinate{500000, 4649776, 15, "T"}, -1},
+		{testCoordinate{500000, 4649776, 15, "Y"}, 5},
+		{testCoordinate{500000, 4649776, 15, ""}, 5},
+		{testCoordinate{500000, 4649776, 0, "T"}, 5},
+		{testCoordinate{50000, 4649776, 15, "T"}, 5},
+		{testCoordinate{950000, 4649776, 15, "T"}, 5},
+		{testCoordinate{500000, -1, 15, "T"}, 5},
+	} {
+		_, err := UTM.UTMToMGRS(data.Easting, data.Northing, data.ZoneNumber, data.ZoneLetter, data.precision)
+		if err == nil {
+			t.Errorf("Expected error. TestMGRSBadInput UTMToMGRS case %d", i)
+		}
+	}
+
+	if _, err := UTM.ToMGRS(85, 0, 5); err == nil {
+		t.Error("Expected error. polar caps are not supported")
+	}
+}
@@ -0,0 +1,231 @@
+package UTM
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Point is a coordinate in the Universal Transverse Mercator system.
+// Hemisphere selects the false northing. Band is the latitude band letter
+// 'C' to 'X', or 0 if only the hemisphere is known.
+type Point struct {
+	Easting    float64
+	Northing   float64
+	ZoneNumber int
+	Band       byte
+	Hemisphere Hemisphere
+}
+
+// Geodetic is a latitude and longitude in degrees.
+type Geodetic struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// String returns the point as "33U 389000 5819000", or as
+// "33 south 389000 5819000" if the band is not known. ParsePoint reads it back.
+func (p Point) String() string {
+	return p.Text(-1)
+}
+
+// Text is String with precision digits after the decimal point of easting and
+// northing. A negative precision uses as many digits as needed to represent
+// the values exactly.
+func (p Point) Text(precision int) string {
+	var b strings.Builder
+
+	b.WriteString(strconv.Itoa(p.ZoneNumber))
+	if p.Band != 0 {
+		b.WriteByte(p.Band)
+	} else {
+		b.WriteByte(' ')
+		b.WriteString(p.Hemisphere.String())
+	}
+	b.WriteByte(' ')
+	b.WriteString(strconv.FormatFloat(p.Easting, 'f', precision, 64))
+	b.WriteByte(' ')
+	b.WriteString(strconv.FormatFloat(p.Northing, 'f', precision, 64))
+
+	return b.String()
+}
+
+// Validate checks the ranges of the fields and that the band lies in the
+// hemisphere.
+func (p Point) Validate() error {
+	if err := validateUTM(p.Easting, p.Northing, p.ZoneNumber); err != nil {
+		return err
+	}
+
+	if !p.Hemisphere.Valid() {
+		return rangeError(ErrHemisphereOutOfRange, FieldHemisphere, float64(p.Hemisphere), float64(Northern), float64(Southern))
+	}
+
+	if p.Band == 0 {
+		return nil
+	}
+
+	band, ok := mgrsBand(string(p.Band))
+	if !ok {
+		return letterError(string(p.Band), 'C', 'X')
+	}
+
+	if (band >= 'N') != (p.Hemisphere == Northern) {
+		return inputError(ErrZoneLetterOutOfRange, FieldZoneLetter,
+			"band "+string(band)+" is not in the "+p.Hemisphere.String())
+	}
+
+	return nil
+}
+
+// Geodetic converts the point to a latitude and longitude.
+func (p Point) Geodetic() (Geodetic, error) {
+	return WGS84.PointToGeodetic(p)
+}
+
+// String returns the latitude and longitude separated by a space.
+func (g Geodetic) String() string {
+	return strconv.FormatFloat(g.Latitude, 'f', -1, 64) + " " + strconv.FormatFloat(g.Longitude, 'f', -1, 64)
+}
+
+// Validate checks latitude and longitude like ValidateLatLone.
+func (g Geodetic) Validate() error {
+	return ValidateLatLone(g.Latitude, g.Longitude)
+}
+
+// Point converts the latitude and longitude to a UTM point with band.
+func (g Geodetic) Point() (Point, error) {
+	return WGS84.GeodeticToPoint(g)
+}
+
+// PointToGeodetic is Point.Geodetic on the ellipsoid.
+func (el *Ellipsoid) PointToGeodetic(p Point) (g Geodetic, err error) {
+	if err = p.Validate(); err != nil {
+		return
+	}
+
+	g.Latitude, g.Longitude, err = el.ToLatLonHemisphere(p.Easting, p.Northing, p.ZoneNumber, p.Hemisphere)
+
+	return
+}
+
+// GeodeticToPoint is Geodetic.Point on the ellipsoid.
+func (el *Ellipsoid) GeodeticToPoint(g Geodetic) (p Point, err error) {
+	var zoneLetter string
+	p.Easting, p.Northing, p.ZoneNumber, zoneLetter, err = el.FromLatLon(g.Latitude, g.Longitude, false)
+	if err != nil {
+		return Point{}, err
+	}
+
+	p.Band = zoneLetter[0]
+	p.Hemisphere = HemisphereOf(g.Latitude)
+
+	return
+}
+
+// ParsePoint parses a UTM coordinate such as "33U 389000 5819000",
+// "33 U 389000mE 5819000mN" or "33 north 389000 5819000". Commas may separate
+// the values, letters are not case sensitive.
+//
+// N and S can be a latitude band or a hemisphere, as in
+// "33N 389000E 5819000N". They are read as the band if the northing lies in
+// it and as the hemisphere otherwise.
+func ParsePoint(s string) (Point, error) {
+	return WGS84.ParsePoint(s)
+}
+
+// ParsePoint is ParsePoint on the ellipsoid, which decides whether N and S
+// are bands or hemispheres.
+func (el *Ellipsoid) ParsePoint(s string) (p Point, err error) {
+	invalid := inputError(ErrInvalidPoint, FieldNone, strconv.Quote(s))
+
+	fields := strings.FieldsFunc(s, func(r rune) bool { return unicode.IsSpace(r) || r == ',' })
+	if !(3 <= len(fields) && len(fields) <= 4) {
+		return Point{}, invalid
+	}
+
+	digits := 0
+	for digits < len(fields[0]) && digits < 2 && '0' <= fields[0][digits] && fields[0][digits] <= '9' {
+		digits++
+	}
+
+	zoneNumber, designator := fields[0][:digits], fields[0][digits:]
+	if len(fields) == 4 {
+		if designator != "" {
+			return Point{}, invalid
+		}
+		designator, fields = fields[1], fields[1:]
+	}
+
+	if zoneNumber == "" || designator == "" {
+		return Point{}, invalid
+	}
+
+	p.ZoneNumber, _ = strconv.Atoi(zoneNumber)
+
+	if p.Easting, err = strconv.ParseFloat(trimUnit(fields[1], "E"), 64); err != nil {
+		return Point{}, invalid
+	}
+	if p.Northing, err = strconv.ParseFloat(trimUnit(fields[2], "N"), 64); err != nil {
+		return Point{}, invalid
+	}
+
+	if err = validateUTM(p.Easting, p.Northing, p.ZoneNumber); err != nil {
+		return Point{}, err
+	}
+
+	switch strings.ToLower(designator) {
+	case "north":
+		p.Hemisphere = Northern
+	case "south":
+		p.Hemisphere = Southern
+	default:
+		band, ok := mgrsBand(designator)
+		if !ok {
+			return Point{}, letterError(designator, 'C', 'X')
+		}
+
+		p.Band, p.Hemisphere = band, Southern
+		if band >= 'N' {
+			p.Hemisphere = Northern
+		}
+
+		if band == 'N' || band == 'S' {
+			latitude, _, _ := el.ToLatLon(p.Easting, p.Northing, p.ZoneNumber, "", true)
+
+			south, north := bandLatitudes(band)
+			if !(south-DefaultStrictTolerance <= latitude && latitude < north+DefaultStrictTolerance) {
+				p.Band = 0
+				if band == 'S' {
+					p.Hemisphere = Southern
+				}
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// trimUnit removes a "m", axis or "m" + axis suffix from a number, in any
+// case.
+func trimUnit(s, axis string) string {
+	return trimSuffixFold(trimSuffixFold(s, axis), "m")
+}
+
+// trimSuffixFold is strings.TrimSuffix ignoring case.
+func trimSuffixFold(s, suffix string) string {
+	if len(s) >= len(suffix) && strings.EqualFold(s[len(s)-len(suffix):], suffix) {
+		return s[:len(s)-len(suffix)]
+	}
+	return s
+}
+
+// bandLatitudes returns the southern and northern edge of a latitude band.
+func bandLatitudes(band byte) (south, north float64) {
+	for i := 1; i < len(zoneLetters); i++ {
+		if zoneLetters[i].letter[0] == band {
+			return float64(zoneLetters[i].zone), float64(zoneLetters[i-1].zone)
+		}
+	}
+	return 0, 0
+}
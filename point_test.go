@@ -0,0 +1,168 @@
+package UTM_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestParsePoint(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		s    string
+		want UTM.Point
+	}{
+		{"33U 389000 5819000", UTM.Point{389000, 5819000, 33, 'U', UTM.Northern}},
+		{"33N 389000E 5819000N", UTM.Point{389000, 5819000, 33, 0, UTM.Northern}},
+		{"33 U 389000mE 5819000mN", UTM.Point{389000, 5819000, 33, 'U', UTM.Northern}},
+		{"33N 389000e 5819000n", UTM.Point{389000, 5819000, 33, 0, UTM.Northern}},
+		{"33u 389000me 5819000mn", UTM.Point{389000, 5819000, 33, 'U', UTM.Northern}},
+		{"33u, 389000.25m, 5819000.5m", UTM.Point{389000.25, 5819000.5, 33, 'U', UTM.Northern}},
+		{"33 north 389000 5819000", UTM.Point{389000, 5819000, 33, 0, UTM.Northern}},
+		{"34 South 261878 6243186", UTM.Point{261878, 6243186, 34, 0, UTM.Southern}},
+		// N and S are bands if the northing fits
+		{"31N 500000 442000", UTM.Point{500000, 442000, 31, 'N', UTM.Northern}},
+		{"18S 500000 4000000", UTM.Point{500000, 4000000, 18, 'S', UTM.Northern}},
+		// and hemispheres otherwise
+		{"34S 261878 6243186", UTM.Point{261878, 6243186, 34, 0, UTM.Southern}},
+		{"60G 313784 5427057", UTM.Point{313784, 5427057, 60, 'G', UTM.Southern}},
+	}
+
+	for i, c := range cases {
+		p, err := UTM.ParsePoint(c.s)
+		if err != nil {
+			t.Errorf("case %d: %v", i, err)
+			continue
+		}
+
+		if p != c.want {
+			t.Errorf("case %d: %+v, want %+v", i, p, c.want)
+		}
+	}
+}
+
+func TestParsePointErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		s        string
+		sentinel error
+	}{
+		{"", UTM.ErrInvalidPoint},
+		{"33U 389000", UTM.ErrInvalidPoint},
+		{"U 389000 5819000", UTM.ErrInvalidPoint},
+		{"33 389000 5819000", UTM.ErrInvalidPoint},
+		{"33U U 389000 5819000", UTM.ErrInvalidPoint},
+		{"33U 389000x 5819000", UTM.ErrInvalidPoint},
+		{"33U 389000 5819000 1", UTM.ErrInvalidPoint},
+		{"33I 389000 5819000", UTM.ErrZoneLetterOutOfRange},
+		{"33 up 389000 5819000", UTM.ErrZoneLetterOutOfRange},
+		{"61U 389000 5819000", UTM.ErrZoneNumberOutOfRange},
+		{"33U 99999 5819000", UTM.ErrEastingOutOfRange},
+		{"33U 389000 -1", UTM.ErrNorthingOutOfRange},
+	}
+
+	for i, c := range cases {
+		if _, err := UTM.ParsePoint(c.s); !errors.Is(err, c.sentinel) {
+			t.Errorf("case %d: %v is not %v", i, err, c.sentinel)
+		}
+	}
+}
+
+func TestPointString(t *testing.T) {
+	t.Parallel()
+
+	points := []UTM.Point{
+		{389000, 5819000, 33, 'U', UTM.Northern},
+		{389000.125, 5819000.5, 33, 'U', UTM.Northern},
+		{261878, 6243186, 34, 0, UTM.Southern},
+		{500000, 4000000, 18, 'S', UTM.Northern},
+	}
+
+	want := []string{
+		"33U 389000 5819000",
+		"33U 389000.125 5819000.5",
+		"34 south 261878 6243186",
+		"18S 500000 4000000",
+	}
+
+	for i, p := range points {
+		if s := p.String(); s != want[i] {
+			t.Errorf("case %d: %q, want %q", i, s, want[i])
+		}
+
+		parsed, err := UTM.ParsePoint(p.String())
+		if err != nil || parsed != p {
+			t.Errorf("case %d: %+v %v, want %+v", i, parsed, err, p)
+		}
+	}
+
+	if s := points[1].Text(1); s != "33U 389000.1 5819000.5" {
+		t.Errorf("Text(1) = %q", s)
+	}
+}
+
+func TestPointGeodetic(t *testing.T) {
+	t.Parallel()
+
+	for i, data := range getTestValues() {
+		g := UTM.Geodetic{Latitude: data.LatLon.Latitude, Longitude: data.LatLon.Longitude}
+
+		p, err := g.Point()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if round(p.Easting) != data.UTM.Easting || round(p.Northing) != data.UTM.Northing ||
+			p.ZoneNumber != data.UTM.ZoneNumber || string(p.Band) != data.UTM.ZoneLetter ||
+			p.Hemisphere != UTM.HemisphereOf(g.Latitude) {
+			t.Errorf("case %d: %+v, want %+v", i, p, data.UTM)
+		}
+
+		back, err := p.Geodetic()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(back.Latitude-g.Latitude) > 1e-7 || math.Abs(back.Longitude-g.Longitude) > 1e-7 {
+			t.Errorf("case %d: %v, want %v", i, back, g)
+		}
+
+		// the band is not needed
+		p.Band = 0
+		if back2, err := p.Geodetic(); err != nil || back2 != back {
+			t.Errorf("case %d: without band %v %v, want %v", i, back2, err, back)
+		}
+	}
+}
+
+func TestPointValidate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		p        UTM.Point
+		sentinel error
+	}{
+		{UTM.Point{389000, 5819000, 33, 'U', 0}, UTM.ErrHemisphereOutOfRange},
+		{UTM.Point{389000, 5819000, 33, 'U', UTM.Southern}, UTM.ErrZoneLetterOutOfRange},
+		{UTM.Point{389000, 5819000, 33, 'Y', UTM.Northern}, UTM.ErrZoneLetterOutOfRange},
+		{UTM.Point{389000, 5819000, 0, 'U', UTM.Northern}, UTM.ErrZoneNumberOutOfRange},
+	}
+
+	for i, c := range cases {
+		if err := c.p.Validate(); !errors.Is(err, c.sentinel) {
+			t.Errorf("case %d: %v is not %v", i, err, c.sentinel)
+		}
+
+		if _, err := c.p.Geodetic(); !errors.Is(err, c.sentinel) {
+			t.Errorf("case %d: Geodetic: %v is not %v", i, err, c.sentinel)
+		}
+	}
+
+	if _, err := (UTM.Geodetic{Latitude: 85}).Point(); !errors.Is(err, UTM.ErrLatitudeOutOfRange) {
+		t.Errorf("Geodetic.Point: %v", err)
+	}
+}
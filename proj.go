@@ -0,0 +1,191 @@
+package UTM
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PROJ names of the predefined ellipsoids.
+var projEllipsoids = map[string]*Ellipsoid{
+	"WGS84":  WGS84,
+	"GRS80":  GRS80,
+	"intl":   International1924,
+	"clrk66": Clarke1866,
+	"bessel": Bessel1841,
+	"krass":  Krassovsky1940,
+	"airy":   Airy1830,
+}
+
+// projDatums are the PROJ datums whose ellipsoid is known. NAD83 is left
+// out: a CRS only records the ellipsoid, and GRS80 zones are ETRS89, so it
+// would come back with the EPSG code and name of the wrong datum.
+var projDatums = map[string]*Ellipsoid{
+	"WGS84":   WGS84,
+	"NAD27":   Clarke1866,
+	"potsdam": Bessel1841,
+}
+
+// PROJ returns the PROJ string of the CRS, e.g.
+// "+proj=utm +zone=33 +south +datum=WGS84 +units=m".
+func (c CRS) PROJ() (string, error) {
+	if err := c.Validate(); err != nil {
+		return "", err
+	}
+
+	el := c.ellipsoid()
+
+	var b strings.Builder
+
+	b.WriteString("+proj=utm +zone=" + strconv.Itoa(c.ZoneNumber))
+	if c.Hemisphere == Southern {
+		b.WriteString(" +south")
+	}
+
+	if el.sameAs(WGS84) {
+		b.WriteString(" +datum=WGS84")
+	} else if name := projEllipsoidName(el); name != "" {
+		b.WriteString(" +ellps=" + name)
+	} else {
+		b.WriteString(" +a=" + formatFloat(el.a) + " +rf=" + formatFloat(el.invF))
+	}
+
+	b.WriteString(" +units=m")
+
+	return b.String(), nil
+}
+
+func projEllipsoidName(el *Ellipsoid) string {
+	for name, predefined := range projEllipsoids {
+		if el.sameAs(predefined) {
+			return name
+		}
+	}
+	return ""
+}
+
+// ParsePROJ parses a PROJ string such as "+proj=utm +zone=33 +south +datum=WGS84 +units=m"
+// or "+init=epsg:32633". The ellipsoid is taken from +datum, +ellps or +a
+// with +rf, +f or +b, and is GRS80 if none is given, as in PROJ.
+// Definitions that are not UTM in metres or use a datum other than WGS84,
+// NAD27 or potsdam return an error wrapping ErrUnsupportedCRS, malformed ones
+// ErrInvalidCRS.
+func ParsePROJ(s string) (CRS, error) {
+	parameters := make(map[string]string)
+
+	for _, field := range strings.Fields(s) {
+		field = strings.TrimPrefix(field, "+")
+		if field == "" {
+			return CRS{}, inputError(ErrInvalidCRS, FieldNone, strconv.Quote(s))
+		}
+
+		key, value := field, ""
+		if i := strings.IndexByte(field, '='); i >= 0 {
+			key, value = field[:i], field[i+1:]
+		}
+		parameters[key] = value
+	}
+
+	if init, ok := parameters["init"]; ok {
+		return ParseEPSG(init)
+	}
+
+	projection, ok := parameters["proj"]
+	if !ok {
+		return CRS{}, inputError(ErrInvalidCRS, FieldNone, "missing +proj")
+	}
+	if projection != "utm" {
+		return CRS{}, inputError(ErrUnsupportedCRS, FieldNone, "+proj="+projection+" is not utm")
+	}
+
+	zone, ok := parameters["zone"]
+	if !ok {
+		return CRS{}, inputError(ErrInvalidCRS, FieldNone, "missing +zone")
+	}
+	zoneNumber, err := strconv.Atoi(zone)
+	if err != nil {
+		return CRS{}, inputError(ErrInvalidCRS, FieldNone, "+zone="+zone)
+	}
+
+	if units, ok := parameters["units"]; ok && units != "m" {
+		return CRS{}, inputError(ErrUnsupportedCRS, FieldNone, "+units="+units+" is not metre")
+	}
+	if toMeter, ok := parameters["to_meter"]; ok {
+		if factor, err := strconv.ParseFloat(toMeter, 64); err != nil || factor != 1 {
+			return CRS{}, inputError(ErrUnsupportedCRS, FieldNone, "+to_meter="+toMeter+" is not metre")
+		}
+	}
+
+	el, err := projEllipsoid(parameters)
+	if err != nil {
+		return CRS{}, err
+	}
+
+	crs := CRS{ZoneNumber: zoneNumber, Hemisphere: Northern, Ellipsoid: el}
+	if _, south := parameters["south"]; south {
+		crs.Hemisphere = Southern
+	}
+
+	if err := crs.Validate(); err != nil {
+		return CRS{}, err
+	}
+
+	return crs, nil
+}
+
+// projEllipsoid returns the ellipsoid of PROJ parameters.
+func projEllipsoid(parameters map[string]string) (*Ellipsoid, error) {
+	if name, ok := parameters["datum"]; ok {
+		if el, ok := projDatums[name]; ok {
+			return el, nil
+		}
+		return nil, inputError(ErrUnsupportedCRS, FieldNone, "unsupported +datum="+name)
+	}
+
+	if name, ok := parameters["ellps"]; ok {
+		if el, ok := projEllipsoids[name]; ok {
+			return el, nil
+		}
+		return nil, inputError(ErrUnsupportedCRS, FieldNone, "unknown +ellps="+name)
+	}
+
+	a, ok := parameters["a"]
+	if !ok {
+		return GRS80, nil
+	}
+
+	number := func(key string) (float64, bool, error) {
+		value, ok := parameters[key]
+		if !ok {
+			return 0, false, nil
+		}
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, false, inputError(ErrInvalidCRS, FieldNone, "+"+key+"="+value)
+		}
+		return f, true, nil
+	}
+
+	semiMajorAxis, _, err := number("a")
+	if err != nil {
+		return nil, err
+	}
+
+	var inverseFlattening float64
+	if rf, ok, err := number("rf"); err != nil {
+		return nil, err
+	} else if ok {
+		inverseFlattening = rf
+	} else if f, ok, err := number("f"); err != nil {
+		return nil, err
+	} else if ok {
+		inverseFlattening = 1 / f
+	} else if b, ok, err := number("b"); err != nil {
+		return nil, err
+	} else if ok {
+		inverseFlattening = semiMajorAxis / (semiMajorAxis - b)
+	} else {
+		return nil, inputError(ErrUnsupportedCRS, FieldNone, "+a="+a+" without flattening")
+	}
+
+	return lookupEllipsoid("unknown", semiMajorAxis, inverseFlattening)
+}
@@ -0,0 +1,105 @@
+package UTM_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestPROJ(t *testing.T) {
+	t.Parallel()
+
+	custom, err := UTM.NewEllipsoid("custom", 6378000, 298)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	cases := []struct {
+		crs  UTM.CRS
+		proj string
+	}{
+		{UTM.CRS{ZoneNumber: 33, Hemisphere: UTM.Southern, Ellipsoid: UTM.WGS84}, "+proj=utm +zone=33 +south +datum=WGS84 +units=m"},
+		{UTM.CRS{ZoneNumber: 32, Hemisphere: UTM.Northern, Ellipsoid: UTM.GRS80}, "+proj=utm +zone=32 +ellps=GRS80 +units=m"},
+		{UTM.CRS{ZoneNumber: 31, Hemisphere: UTM.Northern, Ellipsoid: UTM.International1924}, "+proj=utm +zone=31 +ellps=intl +units=m"},
+		{UTM.CRS{ZoneNumber: 17, Hemisphere: UTM.Northern, Ellipsoid: custom}, "+proj=utm +zone=17 +a=6378000 +rf=298 +units=m"},
+	}
+
+	for i, c := range cases {
+		proj, err := c.crs.PROJ()
+		if err != nil || proj != c.proj {
+			t.Errorf("case %d: %q %v, want %q", i, proj, err, c.proj)
+		}
+
+		crs, err := UTM.ParsePROJ(proj)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if crs.ZoneNumber != c.crs.ZoneNumber || crs.Hemisphere != c.crs.Hemisphere ||
+			crs.Ellipsoid.SemiMajorAxis() != c.crs.Ellipsoid.SemiMajorAxis() ||
+			crs.Ellipsoid.InverseFlattening() != c.crs.Ellipsoid.InverseFlattening() {
+			t.Errorf("case %d: %v, want %v", i, crs, c.crs)
+		}
+	}
+}
+
+func TestParsePROJ(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		proj string
+		want UTM.CRS
+	}{
+		{"+proj=utm +zone=33 +datum=WGS84 +units=m +no_defs +type=crs", UTM.CRS{ZoneNumber: 33, Hemisphere: UTM.Northern, Ellipsoid: UTM.WGS84}},
+		{"+proj=utm +zone=32 +ellps=GRS80 +towgs84=0,0,0,0,0,0,0 +units=m +no_defs", UTM.CRS{ZoneNumber: 32, Hemisphere: UTM.Northern, Ellipsoid: UTM.GRS80}},
+		{"proj=utm zone=18 south ellps=bessel", UTM.CRS{ZoneNumber: 18, Hemisphere: UTM.Southern, Ellipsoid: UTM.Bessel1841}},
+		{"+proj=utm +zone=14 +datum=NAD27", UTM.CRS{ZoneNumber: 14, Hemisphere: UTM.Northern, Ellipsoid: UTM.Clarke1866}},
+		{"+proj=utm +zone=14", UTM.CRS{ZoneNumber: 14, Hemisphere: UTM.Northern, Ellipsoid: UTM.GRS80}},
+		{"+proj=utm +zone=14 +a=6378137 +b=6356752.314245179", UTM.CRS{ZoneNumber: 14, Hemisphere: UTM.Northern, Ellipsoid: UTM.WGS84}},
+		{"+init=epsg:32633", UTM.CRS{ZoneNumber: 33, Hemisphere: UTM.Northern, Ellipsoid: UTM.WGS84}},
+	}
+
+	for i, c := range cases {
+		crs, err := UTM.ParsePROJ(c.proj)
+		if err != nil || crs != c.want {
+			t.Errorf("case %d: %v %v, want %v", i, crs, err, c.want)
+		}
+	}
+
+	errorCases := []struct {
+		proj     string
+		sentinel error
+	}{
+		{"+proj=tmerc +lon_0=15 +k=0.9996 +x_0=500000", UTM.ErrUnsupportedCRS},
+		{"+proj=longlat +datum=WGS84", UTM.ErrUnsupportedCRS},
+		{"+proj=utm +zone=33 +units=us-ft", UTM.ErrUnsupportedCRS},
+		{"+proj=utm +zone=33 +datum=OSGB36", UTM.ErrUnsupportedCRS},
+		// NAD83 would be taken for ETRS89
+		{"+proj=utm +zone=33 +datum=NAD83", UTM.ErrUnsupportedCRS},
+		{"+proj=utm +zone=33 +a=6378137", UTM.ErrUnsupportedCRS},
+		{"+zone=33", UTM.ErrInvalidCRS},
+		{"+proj=utm", UTM.ErrInvalidCRS},
+		{"+proj=utm +zone=x", UTM.ErrInvalidCRS},
+		{"+proj=utm +zone=33 +a=6378137 +rf=x", UTM.ErrInvalidCRS},
+		{"+proj=utm +zone=61", UTM.ErrZoneNumberOutOfRange},
+	}
+
+	for i, c := range errorCases {
+		if _, err := UTM.ParsePROJ(c.proj); !errors.Is(err, c.sentinel) {
+			t.Errorf("case %d: %v is not %v", i, err, c.sentinel)
+		}
+	}
+}
+
+func TestParseCRS(t *testing.T) {
+	t.Parallel()
+
+	want := UTM.CRS{ZoneNumber: 33, Hemisphere: UTM.Northern, Ellipsoid: UTM.WGS84}
+
+	for _, s := range []string{"EPSG:32633", "+proj=utm +zone=33 +datum=WGS84", wkt1UTM33N, wkt2UTM33N} {
+		if crs, err := UTM.ParseCRS(s); err != nil || crs != want {
+			t.Errorf("%.20q: %v %v", s, crs, err)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package UTM
+
+import (
+	"fmt"
+	"math"
+)
+
+// maxReprojectDistance is the distance in metres from the central meridian up
+// to which the Krüger series is accurate, see FromLatLonKrueger.
+const maxReprojectDistance = 4000000
+
+// maxReprojectOffset is the limit ReprojectZone passes to FromLatLonZone. It
+// is independent of DefaultZoneOffset: beyond a quarter turn from the central
+// meridian the series is meaningless, but maxReprojectDistance is the real
+// limit.
+const maxReprojectOffset = 90 - 3
+
+// ReprojectZone convert Universal Transverse Mercator coordinates from one zone and hemisphere
+// to another, e.g. to merge zone 32 data into a zone 33 dataset. The point is
+// converted with ToLatLonKrueger and projected again with FromLatLonZone.
+// It returns an InputError if the point would end up further than 4000 km
+// from the target central meridian, where the series loses its accuracy.
+func ReprojectZone(
+	easting, northing float64,
+	zoneNumber int,
+	northern bool,
+	targetZoneNumber int,
+	targetNorthern bool) (
+	targetEasting, targetNorthing float64, err error,
+) {
+	return WGS84.ReprojectZone(easting, northing, zoneNumber, northern, targetZoneNumber, targetNorthern)
+}
+
+// ReprojectZone is ReprojectZone on the ellipsoid.
+func (el *Ellipsoid) ReprojectZone(
+	easting, northing float64,
+	zoneNumber int,
+	northern bool,
+	targetZoneNumber int,
+	targetNorthern bool) (
+	targetEasting, targetNorthing float64, err error,
+) {
+	latitude, longitude, err := el.ToLatLonKrueger(easting, northing, zoneNumber, "", northern)
+	if err != nil {
+		return
+	}
+
+	targetEasting, targetNorthing, err = el.fromLatLonZone(
+		latitude, normalizeLongitude(longitude), targetZoneNumber, targetNorthern, maxReprojectOffset)
+	if err != nil {
+		return
+	}
+
+	if !(math.Abs(targetEasting-500000) <= k0*maxReprojectDistance) {
+		err = rangeError(ErrEastingOutOfRange, FieldEasting, targetEasting,
+			500000-k0*maxReprojectDistance, 500000+k0*maxReprojectDistance)
+		return 0, 0, err
+	}
+
+	return
+}
+
+// ReprojectZoneBatch is ReprojectZone for many points in the same zone.
+// It stops at the first point which can't be converted and reports its index.
+func ReprojectZoneBatch(
+	eastings, northings []float64,
+	zoneNumber int,
+	northern bool,
+	targetZoneNumber int,
+	targetNorthern bool) (
+	targetEastings, targetNorthings []float64, err error,
+) {
+	return WGS84.ReprojectZoneBatch(eastings, northings, zoneNumber, northern, targetZoneNumber, targetNorthern)
+}
+
+// ReprojectZoneBatch is ReprojectZoneBatch on the ellipsoid.
+func (el *Ellipsoid) ReprojectZoneBatch(
+	eastings, northings []float64,
+	zoneNumber int,
+	northern bool,
+	targetZoneNumber int,
+	targetNorthern bool) (
+	targetEastings, targetNorthings []float64, err error,
+) {
+	if len(eastings) != len(northings) {
+		err = InputError{Err: ErrLengthMismatch}
+		return
+	}
+
+	targetEastings = make([]float64, len(eastings))
+	targetNorthings = make([]float64, len(northings))
+
+	for i := range eastings {
+		targetEastings[i], targetNorthings[i], err = el.ReprojectZone(
+			eastings[i], northings[i], zoneNumber, northern, targetZoneNumber, targetNorthern)
+		if err != nil {
+			err = fmt.Errorf("point %d: %w", i, err)
+			return nil, nil, err
+		}
+	}
+
+	return
+}
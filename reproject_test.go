@@ -0,0 +1,155 @@
+package UTM_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestReprojectZone(t *testing.T) {
+	t.Parallel()
+
+	for i, data := range getTestValues() {
+		easting, northing, zoneNumber, _, err := UTM.FromLatLonKrueger(data.LatLon.Latitude, data.LatLon.Longitude, false)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		northern := data.LatLon.Latitude >= 0
+		targetZoneNumber := zoneNumber%60 + 1
+
+		e, n, err := UTM.ReprojectZone(easting, northing, zoneNumber, northern, targetZoneNumber, northern)
+		if err != nil {
+			t.Fatalf("case %d: %s", i, err)
+		}
+
+		wantE, wantN, err := UTM.FromLatLonZone(
+			data.LatLon.Latitude, data.LatLon.Longitude, targetZoneNumber, "", UTM.DefaultZoneOffset, northern)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(e-wantE) > 1e-6 || math.Abs(n-wantN) > 1e-6 {
+			t.Errorf("case %d: reprojected (%f, %f), want (%f, %f)", i, e, n, wantE, wantN)
+		}
+
+		// ToLatLonKrueger only accepts eastings of the standard zone range.
+		if !(100000 <= e && e < 1000000) {
+			continue
+		}
+
+		backE, backN, err := UTM.ReprojectZone(e, n, targetZoneNumber, northern, zoneNumber, northern)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(backE-easting) > 1e-6 || math.Abs(backN-northing) > 1e-6 {
+			t.Errorf("case %d: round trip (%f, %f), want (%f, %f)", i, backE, backN, easting, northing)
+		}
+	}
+}
+
+func TestReprojectZoneHemisphere(t *testing.T) {
+	t.Parallel()
+
+	e, n, err := UTM.ReprojectZone(500000, 100000, 31, true, 31, false)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(e-500000) > 1e-6 || math.Abs(n-10100000) > 1e-6 {
+		t.Errorf("reprojected to (%f, %f)", e, n)
+	}
+}
+
+func TestReprojectZoneFar(t *testing.T) {
+	t.Parallel()
+
+	// Zone 36 is further from Aachen than DefaultZoneOffset allows, but well
+	// inside the reach of the series.
+	latitude, longitude := 50.77535, 6.08389
+
+	if _, _, err := UTM.FromLatLonZone(latitude, longitude, 36, "", UTM.DefaultZoneOffset, true); err == nil {
+		t.Error("Expected error. zone 36 is beyond DefaultZoneOffset")
+	}
+
+	easting, northing, _, _, err := UTM.FromLatLonKrueger(latitude, longitude, false)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	e, n, err := UTM.ReprojectZone(easting, northing, 32, true, 36, true)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	tm, err := UTM.UTMZone(36, UTM.Northern)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	lat, lon, err := tm.ToLatLon(e, n)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(lat-latitude) > 1e-11 || math.Abs(lon-longitude) > 1e-11 {
+		t.Errorf("reprojected to (%.12f, %.12f)", lat, lon)
+	}
+}
+
+func TestReprojectZoneBadInput(t *testing.T) {
+	t.Parallel()
+
+	// Zone 45 is about 78 degrees away from zone 32.
+	_, _, err := UTM.ReprojectZone(294409, 5628898, 32, true, 45, true)
+	if err == nil {
+		t.Error("Expected error. target zone too far away")
+	}
+	if _, ok := err.(UTM.InputError); !ok {
+		t.Error("Type of error must be UTM.InputError.")
+	}
+
+	_, _, err = UTM.ReprojectZone(99999, 5628898, 32, true, 33, true)
+	if err == nil {
+		t.Error("Expected error. easting out of range")
+	}
+
+	_, _, err = UTM.ReprojectZone(294409, 5628898, 32, true, 61, true)
+	if err == nil {
+		t.Error("Expected error. target zone out of range")
+	}
+}
+
+func TestReprojectZoneBatch(t *testing.T) {
+	t.Parallel()
+
+	eastings := []float64{294409, 300000, 700000}
+	northings := []float64{5628898, 5600000, 5650000}
+
+	targetEastings, targetNorthings, err := UTM.ReprojectZoneBatch(eastings, northings, 32, true, 31, true)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for i := range eastings {
+		e, n, err := UTM.ReprojectZone(eastings[i], northings[i], 32, true, 31, true)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if targetEastings[i] != e || targetNorthings[i] != n {
+			t.Errorf("batch differs from ReprojectZone case %d", i)
+		}
+	}
+
+	if _, _, err = UTM.ReprojectZoneBatch(eastings, northings[:2], 32, true, 31, true); err == nil {
+		t.Error("Expected error. length mismatch")
+	}
+
+	eastings[1] = 99999
+	if _, _, err = UTM.ReprojectZoneBatch(eastings, northings, 32, true, 31, true); err == nil {
+		t.Error("Expected error. easting out of range")
+	}
+}
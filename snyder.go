@@ -0,0 +1,127 @@
+package UTM
+
+import "math"
+
+// snyder holds the coefficients of the truncated transverse Mercator series
+// from Snyder, "Map Projections: A Working Manual", used by FromLatLon and
+// ToLatLon.
+type snyder struct {
+	r   float64 // semi-major axis
+	e   float64 // first eccentricity squared
+	eP2 float64 // second eccentricity squared
+
+	m1, m2, m3, m4 float64 // meridian arc
+	p2, p3, p4, p5 float64 // footpoint latitude
+}
+
+func newSnyder(a, f float64) snyder {
+	e := f * (2 - f)
+	e2 := e * e
+	e3 := e2 * e
+
+	sqrtE := math.Sqrt(1 - e)
+
+	fe := (1 - sqrtE) / (1 + sqrtE)
+	fe2 := fe * fe
+	fe3 := fe2 * fe
+	fe4 := fe3 * fe
+	fe5 := fe4 * fe
+
+	return snyder{
+		r:   a,
+		e:   e,
+		eP2: e / (1.0 - e),
+
+		m1: 1 - e/4 - 3*e2/64 - 5*e3/256,
+		m2: 3*e/8 + 3*e2/32 + 45*e3/1024,
+		m3: 15*e2/256 + 45*e3/1024,
+		m4: 35 * e3 / 3072,
+
+		p2: 3./2*fe - 27./32*fe3 + 269./512*fe5,
+		p3: 21./16*fe2 - 55./32*fe4,
+		p4: 151./96*fe3 - 417./128*fe5,
+		p5: 1097. / 512 * fe4,
+	}
+}
+
+// forward projects a latitude and a longitude offset from the central
+// meridian (both in radians) onto the transverse Mercator plane with unit
+// scale on the central meridian.
+func (s *snyder) forward(latRad, dLonRad float64) (x, y float64) {
+	latSin := math.Sin(latRad)
+	latCos := math.Cos(latRad)
+
+	latTan := latSin / latCos
+	latTan2 := latTan * latTan
+	latTan4 := latTan2 * latTan2
+
+	n := s.r / math.Sqrt(1-s.e*latSin*latSin)
+	c := s.eP2 * latCos * latCos
+
+	a := latCos * dLonRad
+	a2 := a * a
+	a3 := a2 * a
+	a4 := a3 * a
+	a5 := a4 * a
+	a6 := a5 * a
+	m := s.r * (s.m1*latRad -
+		s.m2*math.Sin(2*latRad) +
+		s.m3*math.Sin(4*latRad) -
+		s.m4*math.Sin(6*latRad))
+	x = n * (a +
+		a3/6*(1-latTan2+c) +
+		a5/120*(5-18*latTan2+latTan4+72*c-58*s.eP2))
+	y = m + n*latTan*(a2/2+
+		a4/24*(5-latTan2+9*c+4*c*c)+
+		a6/720*(61-58*latTan2+latTan4+600*c-330*s.eP2))
+
+	return
+}
+
+// inverse is the inverse of forward. It returns the latitude and the
+// longitude offset from the central meridian in radians.
+func (s *snyder) inverse(x, y float64) (latRad, dLonRad float64) {
+	mu := y / (s.r * s.m1)
+
+	pRad := mu +
+		s.p2*math.Sin(2*mu) +
+		s.p3*math.Sin(4*mu) +
+		s.p4*math.Sin(6*mu) +
+		s.p5*math.Sin(8*mu)
+
+	pSin := math.Sin(pRad)
+	pSin2 := pSin * pSin
+
+	pCos := math.Cos(pRad)
+
+	pTan := pSin / pCos
+	pTan2 := pTan * pTan
+	pTan4 := pTan2 * pTan2
+
+	epSin := 1 - s.e*pSin2
+	epSinSqrt := math.Sqrt(1 - s.e*pSin2)
+
+	n := s.r / epSinSqrt
+	rad_ := (1 - s.e) / epSin
+
+	c := s.eP2 * pCos * pCos
+	c2 := c * c
+
+	d := x / n
+	d2 := d * d
+	d3 := d2 * d
+	d4 := d3 * d
+	d5 := d4 * d
+	d6 := d5 * d
+
+	latRad = pRad - (pTan/rad_)*
+		(d2/2-
+			d4/24*(5+3*pTan2+10*c-4*c2-9*s.eP2)+
+			d6/720*(61+90*pTan2+298*c+45*pTan4-252*s.eP2-3*c2))
+
+	dLonRad = (d -
+		d3/6*(1+2*pTan2+c) +
+		d5/120*(5-2*c+28*pTan2-3*c2+8*s.eP2+24*pTan4)) / pCos
+
+	return
+}
@@ -0,0 +1,64 @@
+package UTM
+
+import "strings"
+
+// DefaultStrictTolerance is the default allowance, in degrees, for
+// ToLatLonStrict. It is about 100 m and covers rounded coordinates.
+const DefaultStrictTolerance = 0.001
+
+// ToLatLonStrict convert Universal Transverse Mercator coordinates to a latitude and longitude
+// and checks that they agree with the grid zone. Unlike ToLatLon, which only
+// takes the hemisphere from the zone letter, it returns an InputError
+//   - wrapping ErrInvalidGridZone for grid zones that don't exist, such as 32X,
+//   - wrapping ErrLatitudeOutsideBand if the latitude is outside the band,
+//   - wrapping ErrLongitudeOutsideZone if the longitude is outside the zone,
+//     taking the zones around Norway and Svalbard into account.
+//
+// The latitude and longitude may lie up to tolerance degrees outside the grid
+// zone, DefaultStrictTolerance is a sensible choice. On a consistency error
+// the converted latitude and longitude are returned as well.
+func ToLatLonStrict(
+	easting, northing float64,
+	zoneNumber int,
+	zoneLetter string,
+	tolerance float64) (
+	latitude, longitude float64, err error,
+) {
+	return WGS84.ToLatLonStrict(easting, northing, zoneNumber, zoneLetter, tolerance)
+}
+
+// ToLatLonStrict is ToLatLonStrict on the ellipsoid.
+func (el *Ellipsoid) ToLatLonStrict(
+	easting, northing float64,
+	zoneNumber int,
+	zoneLetter string,
+	tolerance float64) (
+	latitude, longitude float64, err error,
+) {
+	latitude, longitude, err = el.ToLatLon(easting, northing, zoneNumber, zoneLetter)
+	if err != nil {
+		return
+	}
+
+	z := GridZone{ZoneNumber: zoneNumber, Band: strings.ToUpper(zoneLetter)[0]}
+	if len(zoneLetter) != 1 || !z.Valid() {
+		err = inputError(ErrInvalidGridZone, FieldZoneLetter, z.String())
+		return
+	}
+
+	south, north, west, east := z.Bounds()
+
+	if !(south-tolerance <= latitude && latitude <= north+tolerance) {
+		err = rangeError(ErrLatitudeOutsideBand, FieldLatitude, latitude, south, north)
+		return
+	}
+
+	// The longitude is not normalized, so it doesn't wrap around at the
+	// antimeridian.
+	if !(west-tolerance <= longitude && longitude <= east+tolerance) {
+		err = rangeError(ErrLongitudeOutsideZone, FieldLongitude, longitude, west, east)
+		return
+	}
+
+	return
+}
@@ -0,0 +1,90 @@
+package UTM_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestToLatLonStrict(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range getTestValues() {
+		latitude, longitude, err := UTM.ToLatLonStrict(data.UTM.Easting, data.UTM.Northing, data.UTM.ZoneNumber, data.UTM.ZoneLetter, UTM.DefaultStrictTolerance)
+		if err != nil {
+			t.Errorf("%v: %v", data.UTM, err)
+		}
+
+		wantLatitude, wantLongitude, _ := UTM.ToLatLon(data.UTM.Easting, data.UTM.Northing, data.UTM.ZoneNumber, data.UTM.ZoneLetter)
+		if latitude != wantLatitude || longitude != wantLongitude {
+			t.Errorf("%v: (%f, %f), want (%f, %f)", data.UTM, latitude, longitude, wantLatitude, wantLongitude)
+		}
+	}
+
+	// inside the widened zone 32V, outside the nominal zone 32
+	easting, northing, zoneNumber, zoneLetter, err := UTM.FromLatLon(60, 4, false)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, _, err := UTM.ToLatLonStrict(easting, northing, zoneNumber, zoneLetter, 0); err != nil {
+		t.Errorf("32V: %v", err)
+	}
+}
+
+func TestToLatLonStrictErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		testCoordinate
+		tolerance float64
+		sentinel  error
+		field     UTM.Field
+	}{
+		// grid zones that don't exist
+		{testCoordinate{500000, 8500000, 32, "X"}, 0, UTM.ErrInvalidGridZone, UTM.FieldZoneLetter},
+		{testCoordinate{500000, 8500000, 34, "x"}, 0, UTM.ErrInvalidGridZone, UTM.FieldZoneLetter},
+		// band C at 60 deg S
+		{testCoordinate{500000, 3348000, 30, "C"}, 0, UTM.ErrLatitudeOutsideBand, UTM.FieldLatitude},
+		// Ben Nevis is in band V
+		{testCoordinate{377486, 6296562, 30, "U"}, UTM.DefaultStrictTolerance, UTM.ErrLatitudeOutsideBand, UTM.FieldLatitude},
+		// 6 deg from the central meridian
+		{testCoordinate{140000, 5628898, 32, "U"}, UTM.DefaultStrictTolerance, UTM.ErrLongitudeOutsideZone, UTM.FieldLongitude},
+		// 31V ends at 3 deg E, 32V takes over
+		{testCoordinate{611000, 6652000, 31, "V"}, UTM.DefaultStrictTolerance, UTM.ErrLongitudeOutsideZone, UTM.FieldLongitude},
+		// the usual errors of ToLatLon
+		{testCoordinate{99999, 6296562, 30, "V"}, 0, UTM.ErrEastingOutOfRange, UTM.FieldEasting},
+		{testCoordinate{377486, 6296562, 30, "Y"}, 0, UTM.ErrZoneLetterOutOfRange, UTM.FieldZoneLetter},
+	}
+
+	for i, c := range cases {
+		_, _, err := UTM.ToLatLonStrict(c.Easting, c.Northing, c.ZoneNumber, c.ZoneLetter, c.tolerance)
+		if !errors.Is(err, c.sentinel) {
+			t.Errorf("case %d: %v is not %v", i, err, c.sentinel)
+		}
+
+		var inputErr UTM.InputError
+		if !errors.As(err, &inputErr) || inputErr.Field != c.field {
+			t.Errorf("case %d: %v is not an InputError on %s", i, err, c.field)
+		}
+	}
+}
+
+func TestToLatLonStrictTolerance(t *testing.T) {
+	t.Parallel()
+
+	// 50 m north of band U
+	easting, northing, _, _, err := UTM.FromLatLon(56.0005, 15, false)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, _, err := UTM.ToLatLonStrict(easting, northing, 33, "U", UTM.DefaultStrictTolerance); err != nil {
+		t.Errorf("within tolerance: %v", err)
+	}
+
+	if _, _, err := UTM.ToLatLonStrict(easting, northing, 33, "U", 0); !errors.Is(err, UTM.ErrLatitudeOutsideBand) {
+		t.Errorf("without tolerance: %v", err)
+	}
+}
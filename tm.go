@@ -0,0 +1,153 @@
+package UTM
+
+import "math"
+
+// TransverseMercator is a transverse Mercator projection, the projection
+// behind UTM, Gauss–Krüger and many national grids. It uses the Krüger
+// series of FromLatLonKrueger and has the same accuracy. A nil Ellipsoid
+// stands for WGS84.
+//
+// UTMZone returns the projection of a UTM zone. It gives the results of
+// FromLatLonKrueger and ToLatLonKrueger, not of FromLatLon and ToLatLon, which
+// keep using their faster truncated series.
+type TransverseMercator struct {
+	CentralMeridian  float64 // degrees
+	LatitudeOfOrigin float64 // degrees
+	ScaleFactor      float64 // on the central meridian
+	FalseEasting     float64 // metres
+	FalseNorthing    float64 // metres
+	Ellipsoid        *Ellipsoid
+}
+
+// UTMZone returns the projection of a UTM zone on WGS84: central meridian of
+// the zone, scale factor 0.9996, false easting 500000 m and false northing
+// 10000000 m in the south.
+func UTMZone(zoneNumber int, hemisphere Hemisphere) (TransverseMercator, error) {
+	return WGS84.UTMZone(zoneNumber, hemisphere)
+}
+
+// UTMZone is UTMZone on the ellipsoid.
+func (el *Ellipsoid) UTMZone(zoneNumber int, hemisphere Hemisphere) (TransverseMercator, error) {
+	return CRS{zoneNumber, hemisphere, el}.TransverseMercator()
+}
+
+// TransverseMercator returns the projection of the CRS, see UTMZone.
+func (c CRS) TransverseMercator() (TransverseMercator, error) {
+	if err := c.Validate(); err != nil {
+		return TransverseMercator{}, err
+	}
+
+	return c.ellipsoid().utm(c.ZoneNumber, c.Hemisphere == Northern), nil
+}
+
+// utm returns the projection of a UTM zone without checking the zone number.
+func (el *Ellipsoid) utm(zoneNumber int, northern bool) TransverseMercator {
+	tm := TransverseMercator{
+		CentralMeridian: float64(zoneNumberToCentralLongitude(zoneNumber)),
+		ScaleFactor:     k0,
+		FalseEasting:    500000,
+		Ellipsoid:       el,
+	}
+
+	if !northern {
+		tm.FalseNorthing = 10000000
+	}
+
+	return tm
+}
+
+// Validate checks the parameters of the projection.
+func (tm TransverseMercator) Validate() error {
+	if !(-180 <= tm.CentralMeridian && tm.CentralMeridian <= 180) {
+		return rangeError(ErrLongitudeOutOfRange, FieldLongitude, tm.CentralMeridian, -180, 180)
+	}
+
+	if !(-90 < tm.LatitudeOfOrigin && tm.LatitudeOfOrigin < 90) {
+		return rangeError(ErrLatitudeOutOfRange, FieldLatitude, tm.LatitudeOfOrigin, -90, 90)
+	}
+
+	if !(tm.ScaleFactor > 0) || math.IsInf(tm.ScaleFactor, 0) {
+		return inputError(ErrInvalidProjection, FieldNone, "scale factor must be positive")
+	}
+
+	if math.IsNaN(tm.FalseEasting) || math.IsInf(tm.FalseEasting, 0) ||
+		math.IsNaN(tm.FalseNorthing) || math.IsInf(tm.FalseNorthing, 0) {
+		return inputError(ErrInvalidProjection, FieldNone, "false easting and northing must be finite")
+	}
+
+	return nil
+}
+
+// FromLatLon projects a latitude and longitude. The longitude must be less
+// than 90 degrees from the central meridian.
+func (tm TransverseMercator) FromLatLon(latitude, longitude float64) (easting, northing float64, err error) {
+	if err = tm.Validate(); err != nil {
+		return
+	}
+
+	if !(-90 <= latitude && latitude <= 90) {
+		err = rangeError(ErrLatitudeOutOfRange, FieldLatitude, latitude, -90, 90)
+		return
+	}
+
+	dLon := normalizeLongitude(longitude - tm.CentralMeridian)
+	if !(math.Abs(dLon) < 90) || !(-180 <= longitude && longitude <= 180) {
+		err = rangeError(ErrLongitudeOutOfRange, FieldLongitude, longitude, tm.CentralMeridian-90, tm.CentralMeridian+90)
+		return
+	}
+
+	easting, northing = tm.forward(rad(latitude), rad(dLon))
+
+	return
+}
+
+// ToLatLon is the inverse of FromLatLon. The longitude is normalized to
+// [-180, 180).
+func (tm TransverseMercator) ToLatLon(easting, northing float64) (latitude, longitude float64, err error) {
+	if err = tm.Validate(); err != nil {
+		return
+	}
+
+	latRad, dLonRad := tm.inverse(easting, northing)
+
+	latitude = deg(latRad)
+	longitude = normalizeLongitude(tm.CentralMeridian + deg(dLonRad))
+
+	return
+}
+
+func (tm TransverseMercator) ellipsoid() *Ellipsoid {
+	if tm.Ellipsoid == nil {
+		return WGS84
+	}
+	return tm.Ellipsoid
+}
+
+// originNorthing returns the distance along the central meridian from the
+// equator to the latitude of origin, at unit scale.
+func (tm TransverseMercator) originNorthing() float64 {
+	if tm.LatitudeOfOrigin == 0 {
+		return 0
+	}
+	_, y := tm.ellipsoid().krueger.forward(rad(tm.LatitudeOfOrigin), 0)
+	return y
+}
+
+// forward projects a latitude and a longitude offset from the central
+// meridian, both in radians, without checking them.
+func (tm TransverseMercator) forward(latRad, dLonRad float64) (easting, northing float64) {
+	x, y := tm.ellipsoid().krueger.forward(latRad, dLonRad)
+
+	easting = tm.ScaleFactor*x + tm.FalseEasting
+	northing = tm.ScaleFactor*(y-tm.originNorthing()) + tm.FalseNorthing
+
+	return
+}
+
+// inverse is the inverse of forward.
+func (tm TransverseMercator) inverse(easting, northing float64) (latRad, dLonRad float64) {
+	x := (easting - tm.FalseEasting) / tm.ScaleFactor
+	y := (northing-tm.FalseNorthing)/tm.ScaleFactor + tm.originNorthing()
+
+	return tm.ellipsoid().krueger.inverse(x, y)
+}
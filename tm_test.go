@@ -0,0 +1,139 @@
+package UTM_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestTransverseMercatorUTM(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range getTestValues() {
+		easting, northing, zoneNumber, _, err := UTM.FromLatLonKrueger(data.LatLon.Latitude, data.LatLon.Longitude, false)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		tm, err := UTM.UTMZone(zoneNumber, UTM.HemisphereOf(data.LatLon.Latitude))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		e, n, err := tm.FromLatLon(data.LatLon.Latitude, data.LatLon.Longitude)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		// the longitude is normalized differently
+		if math.Abs(e-easting) > 1e-6 || math.Abs(n-northing) > 1e-6 {
+			t.Errorf("%v: (%f, %f), want (%f, %f)", data.LatLon, e, n, easting, northing)
+		}
+
+		latitude, longitude, err := tm.ToLatLon(e, n)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(latitude-data.LatLon.Latitude) > 1e-12 || math.Abs(longitude-data.LatLon.Longitude) > 1e-12 {
+			t.Errorf("%v: (%f, %f)", data.LatLon, latitude, longitude)
+		}
+	}
+}
+
+func TestTransverseMercatorNationalGrid(t *testing.T) {
+	t.Parallel()
+
+	// British National Grid
+	tm := UTM.TransverseMercator{
+		CentralMeridian:  -2,
+		LatitudeOfOrigin: 49,
+		ScaleFactor:      0.9996012717,
+		FalseEasting:     400000,
+		FalseNorthing:    -100000,
+		Ellipsoid:        UTM.Airy1830,
+	}
+
+	// worked example of the Ordnance Survey guide to coordinate systems
+	latitude := 52 + 39/60.0 + 27.2531/3600
+	longitude := 1 + 43/60.0 + 4.5177/3600
+
+	easting, northing, err := tm.FromLatLon(latitude, longitude)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(easting-651409.903) > 1e-3 || math.Abs(northing-313177.270) > 1e-3 {
+		t.Errorf("(%f, %f), want (651409.903, 313177.270)", easting, northing)
+	}
+
+	lat, lon, err := tm.ToLatLon(easting, northing)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if math.Abs(lat-latitude) > 1e-12 || math.Abs(lon-longitude) > 1e-12 {
+		t.Errorf("(%f, %f), want (%f, %f)", lat, lon, latitude, longitude)
+	}
+}
+
+func TestTransverseMercatorRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tm := UTM.TransverseMercator{
+		CentralMeridian:  179,
+		LatitudeOfOrigin: -30,
+		ScaleFactor:      1.0001,
+		FalseEasting:     1000000,
+		FalseNorthing:    2000000,
+		Ellipsoid:        UTM.Bessel1841,
+	}
+
+	for latitude := -85.0; latitude <= 85; latitude += 17 {
+		for dLon := -30.0; dLon <= 30; dLon += 7.5 {
+			easting, northing, err := tm.FromLatLon(latitude, math.Remainder(179+dLon, 360))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			lat, lon, err := tm.ToLatLon(easting, northing)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			if math.Abs(lat-latitude) > 1e-9 || math.Abs(math.Remainder(lon-179-dLon, 360)) > 1e-9 {
+				t.Errorf("(%f, %f): (%f, %f)", latitude, 179+dLon, lat, lon)
+			}
+		}
+	}
+}
+
+func TestTransverseMercatorErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		tm                  UTM.TransverseMercator
+		latitude, longitude float64
+		sentinel            error
+	}{
+		{UTM.TransverseMercator{ScaleFactor: 0}, 0, 0, UTM.ErrInvalidProjection},
+		{UTM.TransverseMercator{ScaleFactor: 1, FalseEasting: math.Inf(1)}, 0, 0, UTM.ErrInvalidProjection},
+		{UTM.TransverseMercator{ScaleFactor: 1, CentralMeridian: 190}, 0, 0, UTM.ErrLongitudeOutOfRange},
+		{UTM.TransverseMercator{ScaleFactor: 1, LatitudeOfOrigin: 90}, 0, 0, UTM.ErrLatitudeOutOfRange},
+		{UTM.TransverseMercator{ScaleFactor: 1}, 91, 0, UTM.ErrLatitudeOutOfRange},
+		{UTM.TransverseMercator{ScaleFactor: 1}, 0, 90, UTM.ErrLongitudeOutOfRange},
+		{UTM.TransverseMercator{ScaleFactor: 1}, 0, 181, UTM.ErrLongitudeOutOfRange},
+	}
+
+	for i, c := range cases {
+		if _, _, err := c.tm.FromLatLon(c.latitude, c.longitude); !errors.Is(err, c.sentinel) {
+			t.Errorf("case %d: %v is not %v", i, err, c.sentinel)
+		}
+	}
+
+	if _, err := UTM.UTMZone(61, UTM.Northern); !errors.Is(err, UTM.ErrZoneNumberOutOfRange) {
+		t.Errorf("UTMZone: %v", err)
+	}
+}
@@ -0,0 +1,234 @@
+package UTM
+
+import (
+	"math"
+	"unicode"
+)
+
+const (
+	upsK0            = 0.994
+	upsFalseEasting  = 2000000
+	upsFalseNorthing = 2000000
+)
+
+// Latitude limits of the polar caps including the 30' overlap with UTM.
+const (
+	upsMinNorthLatitude = 83.5
+	upsMaxSouthLatitude = -79.5
+)
+
+// FromLatLonUPS convert a latitude and longitude to Universal Polar Stereographic coordinates.
+// The zone letter is "Y" or "Z" in the north and "A" or "B" in the south for
+// western and eastern longitudes respectively. The latitude must be north of
+// 83.5 deg N or south of 79.5 deg S, which includes the 30' overlap with UTM.
+func FromLatLonUPS(latitude, longitude float64) (
+	easting, northing float64, zoneLetter string, err error,
+) {
+	return WGS84.FromLatLonUPS(latitude, longitude)
+}
+
+// FromLatLonUPS is FromLatLonUPS on the ellipsoid.
+func (el *Ellipsoid) FromLatLonUPS(latitude, longitude float64) (
+	easting, northing float64, zoneLetter string, err error,
+) {
+	if !(upsMinNorthLatitude <= latitude && latitude <= 90 || -90 <= latitude && latitude <= upsMaxSouthLatitude) {
+		err = upsLatitudeError(latitude)
+		return
+	}
+	if !(-180.0 <= longitude && longitude <= 180.0) {
+		err = rangeError(ErrLongitudeOutOfRange, FieldLongitude, longitude, -180, 180)
+		return
+	}
+
+	northern := latitude > 0
+	longitude = normalizeLongitude(longitude)
+
+	zoneLetter = upsZoneLetter(northern, longitude)
+
+	latRad := rad(math.Abs(latitude))
+	lonRad := rad(longitude)
+
+	rho := el.upsRho(latRad)
+
+	easting = upsFalseEasting + rho*math.Sin(lonRad)
+	if northern {
+		northing = upsFalseNorthing - rho*math.Cos(lonRad)
+	} else {
+		northing = upsFalseNorthing + rho*math.Cos(lonRad)
+	}
+
+	return
+}
+
+// ToLatLonUPS convert Universal Polar Stereographic coordinates to a latitude and longitude.
+// The zone letter must be one of "A", "B", "Y" or "Z" and agree with the side
+// of the easting: "A" and "Y" lie west of the false easting, "B" and "Z" east
+// of it. Eastings on the false easting, through the pole, fit either letter.
+func ToLatLonUPS(easting, northing float64, zoneLetter string) (
+	latitude, longitude float64, err error,
+) {
+	return WGS84.ToLatLonUPS(easting, northing, zoneLetter)
+}
+
+// ToLatLonUPS is ToLatLonUPS on the ellipsoid.
+func (el *Ellipsoid) ToLatLonUPS(easting, northing float64, zoneLetter string) (
+	latitude, longitude float64, err error,
+) {
+	if zoneLetter == "" {
+		err = upsZoneLetterError(zoneLetter)
+		return
+	}
+
+	var northern, western bool
+
+	letter := unicode.ToUpper(rune(zoneLetter[0]))
+	switch letter {
+	case 'A', 'B':
+		northern = false
+	case 'Y', 'Z':
+		northern = true
+	default:
+		err = upsZoneLetterError(zoneLetter)
+		return
+	}
+	western = letter == 'A' || letter == 'Y'
+
+	if !(0 <= easting && easting <= 2*upsFalseEasting) {
+		err = rangeError(ErrEastingOutOfRange, FieldEasting, easting, 0, 2*upsFalseEasting)
+		return
+	}
+
+	if western && easting > upsFalseEasting || !western && easting < upsFalseEasting {
+		err = inputError(ErrZoneLetterOutOfRange, FieldZoneLetter,
+			"zone "+string(letter)+" does not contain easting "+formatFloat(easting))
+		return
+	}
+
+	if !(0 <= northing && northing <= 2*upsFalseNorthing) {
+		err = rangeError(ErrNorthingOutOfRange, FieldNorthing, northing, 0, 2*upsFalseNorthing)
+		return
+	}
+
+	x := easting - upsFalseEasting
+	y := northing - upsFalseNorthing
+
+	if northern {
+		y = -y
+	}
+
+	latRad := el.upsLatitude(math.Hypot(x, y))
+
+	latitude = deg(latRad)
+	longitude = deg(math.Atan2(x, y))
+
+	if !northern {
+		latitude = -latitude
+	}
+
+	if !(upsMinNorthLatitude <= latitude || latitude <= upsMaxSouthLatitude) {
+		err = upsLatitudeError(latitude)
+		return 0, 0, err
+	}
+
+	return
+}
+
+// FromLatLonAuto convert a latitude and longitude to UTM coordinates between
+// 80 deg S and 84 deg N and to UPS coordinates on the polar caps. UPS
+// coordinates are reported with zone number 0.
+func FromLatLonAuto(latitude, longitude float64) (
+	easting, northing float64, zoneNumber int, zoneLetter string, err error,
+) {
+	return WGS84.FromLatLonAuto(latitude, longitude)
+}
+
+// FromLatLonAuto is FromLatLonAuto on the ellipsoid.
+func (el *Ellipsoid) FromLatLonAuto(latitude, longitude float64) (
+	easting, northing float64, zoneNumber int, zoneLetter string, err error,
+) {
+	if -80 <= latitude && latitude <= 84 {
+		return el.FromLatLon(latitude, longitude, false)
+	}
+
+	easting, northing, zoneLetter, err = el.FromLatLonUPS(latitude, longitude)
+
+	return
+}
+
+// ToLatLonAuto convert UTM or UPS coordinates, as returned by FromLatLonAuto,
+// to a latitude and longitude. Zone number 0 selects UPS.
+func ToLatLonAuto(easting, northing float64, zoneNumber int, zoneLetter string) (
+	latitude, longitude float64, err error,
+) {
+	return WGS84.ToLatLonAuto(easting, northing, zoneNumber, zoneLetter)
+}
+
+// ToLatLonAuto is ToLatLonAuto on the ellipsoid.
+func (el *Ellipsoid) ToLatLonAuto(easting, northing float64, zoneNumber int, zoneLetter string) (
+	latitude, longitude float64, err error,
+) {
+	if zoneNumber == 0 {
+		return el.ToLatLonUPS(easting, northing, zoneLetter)
+	}
+
+	return el.ToLatLon(easting, northing, zoneNumber, zoneLetter)
+}
+
+func upsLatitudeError(latitude float64) InputError {
+	if latitude >= 0 {
+		return rangeError(ErrLatitudeOutOfRange, FieldLatitude, latitude, upsMinNorthLatitude, 90)
+	}
+	return rangeError(ErrLatitudeOutOfRange, FieldLatitude, latitude, -90, upsMaxSouthLatitude)
+}
+
+func upsZoneLetterError(zoneLetter string) InputError {
+	err := letterError(zoneLetter, 'A', 'Z')
+	err.detail = "must be A, B, Y or Z"
+	return err
+}
+
+func upsZoneLetter(northern bool, longitude float64) string {
+	switch {
+	case northern && longitude < 0:
+		return "Y"
+	case northern:
+		return "Z"
+	case longitude < 0:
+		return "A"
+	default:
+		return "B"
+	}
+}
+
+// upsC is the factor sqrt((1+e)^(1+e) (1-e)^(1-e)) of the polar stereographic projection.
+func (el *Ellipsoid) upsC() float64 {
+	e := el.krueger.e
+	return math.Sqrt(math.Pow(1+e, 1+e) * math.Pow(1-e, 1-e))
+}
+
+// upsRho returns the distance from the pole of a point with the given
+// positive latitude in radians.
+func (el *Ellipsoid) upsRho(latRad float64) float64 {
+	e := el.krueger.e
+	eSin := e * math.Sin(latRad)
+	t := math.Tan(math.Pi/4-latRad/2) * math.Pow((1+eSin)/(1-eSin), e/2)
+	return 2 * el.a * upsK0 * t / el.upsC()
+}
+
+// upsLatitude is the inverse of upsRho.
+func (el *Ellipsoid) upsLatitude(rho float64) float64 {
+	e := el.krueger.e
+	t := rho * el.upsC() / (2 * el.a * upsK0)
+
+	latRad := math.Pi/2 - 2*math.Atan(t)
+	for i := 0; i < 20; i++ {
+		eSin := e * math.Sin(latRad)
+		next := math.Pi/2 - 2*math.Atan(t*math.Pow((1-eSin)/(1+eSin), e/2))
+		if math.Abs(next-latRad) < 1e-15 {
+			return next
+		}
+		latRad = next
+	}
+
+	return latRad
+}
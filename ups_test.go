@@ -0,0 +1,189 @@
+package UTM_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+func TestFromLatLonUPSPoles(t *testing.T) {
+	t.Parallel()
+
+	for _, latitude := range []float64{90, -90} {
+		easting, northing, _, err := UTM.FromLatLonUPS(latitude, 0)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if math.Abs(easting-2000000) > 1e-6 || math.Abs(northing-2000000) > 1e-6 {
+			t.Errorf("pole %f: (%f, %f)", latitude, easting, northing)
+		}
+	}
+}
+
+// TestFromLatLonUPSScale checks the scale factor k0 = 0.994 at the pole
+// against the polar radius of curvature a^2/b.
+func TestFromLatLonUPSScale(t *testing.T) {
+	t.Parallel()
+
+	const dLat = 1e-4
+
+	_, northing, _, err := UTM.FromLatLonUPS(90-dLat, 0)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	a := UTM.WGS84.SemiMajorAxis()
+	arc := a * a / UTM.WGS84.SemiMinorAxis() * dLat * math.Pi / 180
+
+	if k := (2000000 - northing) / arc; math.Abs(k-0.994) > 1e-9 {
+		t.Errorf("scale at the pole = %.12f", k)
+	}
+}
+
+func TestUPSZoneLetters(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		latitude, longitude float64
+		zoneLetter          string
+		east                bool
+		north               bool
+	}{
+		{85, -45, "Y", false, false},
+		{85, 45, "Z", true, false},
+		{85, 135, "Z", true, true},
+		{-85, -45, "A", false, true},
+		{-85, 45, "B", true, true},
+		{-85, -135, "A", false, false},
+	}
+
+	for i, c := range cases {
+		easting, northing, zoneLetter, err := UTM.FromLatLonUPS(c.latitude, c.longitude)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if zoneLetter != c.zoneLetter {
+			t.Errorf("case %d: zone letter %q, want %q", i, zoneLetter, c.zoneLetter)
+		}
+
+		if (easting > 2000000) != c.east || (northing > 2000000) != c.north {
+			t.Errorf("case %d: wrong quadrant (%f, %f)", i, easting, northing)
+		}
+	}
+}
+
+func TestUPSRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, latitude := range []float64{-90, -89.5, -85, -80.5, -79.5, 83.5, 84, 86.25, 89.999, 90} {
+		for longitude := -180.0; longitude < 180; longitude += 7.5 {
+			easting, northing, zoneLetter, err := UTM.FromLatLonUPS(latitude, longitude)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			lat, lon, err := UTM.ToLatLonUPS(easting, northing, zoneLetter)
+			if err != nil {
+				t.Fatalf("(%f, %f): %s", latitude, longitude, err)
+			}
+
+			if math.Abs(lat-latitude) > 1e-11 {
+				t.Errorf("(%f, %f): latitude %.12f", latitude, longitude, lat)
+			}
+
+			// The longitude is undefined at the poles.
+			if dLon := math.Remainder(lon-longitude, 360); math.Abs(latitude) != 90 && math.Abs(dLon) > 1e-9 {
+				t.Errorf("(%f, %f): longitude %.12f", latitude, longitude, lon)
+			}
+		}
+	}
+}
+
+func TestUPSBadInput(t *testing.T) {
+	t.Parallel()
+
+	for i, data := range []testLatLon{{83, 0}, {-79, 0}, {0, 0}, {91, 0}, {-91, 0}, {85, 181}, {85, -181}} {
+		_, _, _, err := UTM.FromLatLonUPS(data.Latitude, data.Longitude)
+		if err == nil {
+			t.Errorf("Expected error. TestUPSBadInput FromLatLonUPS case %d", i)
+		}
+		if _, ok := err.(UTM.InputError); !ok {
+			t.Error("Type of error must be UTM.InputError.")
+		}
+	}
+
+	for i, data := range []testCoordinate{
+		{2000000, 2000000, 0, ""},
+		{2000000, 2000000, 0, "C"},
+		{2000000, 2000000, 0, "X"},
+		{-1, 2000000, 0, "Z"},
+		{2000000, 4000001, 0, "Z"},
+		// 83 deg N, outside the overlap
+		{2000000, 2000000 - 780000, 0, "Z"},
+	} {
+		_, _, err := UTM.ToLatLonUPS(data.Easting, data.Northing, data.ZoneLetter)
+		if err == nil {
+			t.Errorf("Expected error. TestUPSBadInput ToLatLonUPS case %d", i)
+		}
+		if _, ok := err.(UTM.InputError); !ok {
+			t.Error("Type of error must be UTM.InputError.")
+		}
+	}
+}
+
+func TestUPSZoneLetterSide(t *testing.T) {
+	t.Parallel()
+
+	// the zone letter is on the wrong side of the pole
+	for _, data := range []testCoordinate{
+		{1900000, 2000000, 0, "Z"},
+		{1900000, 2000000, 0, "b"},
+		{2100000, 2000000, 0, "Y"},
+		{2100000, 2000000, 0, "A"},
+	} {
+		if _, _, err := UTM.ToLatLonUPS(data.Easting, data.Northing, data.ZoneLetter); !errors.Is(err, UTM.ErrZoneLetterOutOfRange) {
+			t.Errorf("%s %f: %v is not %v", data.ZoneLetter, data.Easting, err, UTM.ErrZoneLetterOutOfRange)
+		}
+	}
+
+	// the pole has easting 2000000 whatever the longitude
+	easting, northing, zoneLetter, err := UTM.FromLatLonUPS(90, -10)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, _, err := UTM.ToLatLonUPS(easting, northing, zoneLetter); err != nil {
+		t.Errorf("pole in zone %s: %s", zoneLetter, err)
+	}
+}
+
+func TestLatLonAuto(t *testing.T) {
+	t.Parallel()
+
+	for latitude := -90.0; latitude <= 90; latitude += 0.5 {
+		longitude := 17.25
+
+		easting, northing, zoneNumber, zoneLetter, err := UTM.FromLatLonAuto(latitude, longitude)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		ups := latitude < -80 || latitude > 84
+		if ups != (zoneNumber == 0) {
+			t.Errorf("%f: zone number %d", latitude, zoneNumber)
+		}
+
+		lat, lon, err := UTM.ToLatLonAuto(easting, northing, zoneNumber, zoneLetter)
+		if err != nil {
+			t.Fatalf("%f: %s", latitude, err)
+		}
+
+		if math.Abs(lat-latitude) > 1e-6 || math.Abs(latitude) != 90 && math.Abs(lon-longitude) > 1e-6 {
+			t.Errorf("%f: round trip (%f, %f)", latitude, lat, lon)
+		}
+	}
+}
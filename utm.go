@@ -1,47 +1,14 @@
-// Package UTM is bidirectional UTM-WGS84 converter for golang
+// Package UTM is bidirectional UTM-WGS84 converter for golang.
+// The package level functions work on WGS84, the methods of Ellipsoid on any
+// other reference ellipsoid.
 package UTM
 
 import (
-	"errors"
 	"math"
 	"unicode"
 )
 
-const (
-	k0 = 0.9996
-	e  = 0.00669438
-	r  = 6378137
-)
-
-var (
-	e2  = e * e
-	e3  = e2 * e
-	eP2 = e / (1.0 - e)
-)
-
-var sqrtE = math.Sqrt(1 - e)
-
-var (
-	fe  = (1 - sqrtE) / (1 + sqrtE)
-	fe2 = fe * fe
-	fe3 = fe2 * fe
-	fe4 = fe3 * fe
-	fe5 = fe4 * fe
-)
-
-var (
-	m1 = 1 - e/4 - 3*e2/64 - 5*e3/256
-	m2 = 3*e/8 + 3*e2/32 + 45*e3/1024
-	m3 = 15*e2/256 + 45*e3/1024
-	m4 = 35 * e3 / 3072
-)
-
-var (
-	p2 = 3./2*fe - 27./32*fe3 + 269./512*fe5
-	p3 = 21./16*fe2 - 55./32*fe4
-	p4 = 151./96*fe3 - 417./128*fe5
-	p5 = 1097. / 512 * fe4
-)
+const k0 = 0.9996
 
 type zoneLetterT struct {
 	zone   int
@@ -82,6 +49,7 @@ var zoneLetters = []zoneLetterT{
 // the "northern" parameter instead, which is a named parameter and can be set
 // to either true or false. In this case you should define fields clearly
 // You can't set ZoneLetter or northern both.
+// The zone letter is always read as a latitude band, see ToLatLonHemisphere.
 func ToLatLon(
 	easting, northing float64,
 	zoneNumber int,
@@ -89,38 +57,100 @@ func ToLatLon(
 	northern ...bool) (
 	latitude, longitude float64, err error,
 ) {
-	northernExist := len(northern) > 0
-	zoneLetterExist := !(zoneLetter == "")
+	return WGS84.ToLatLon(easting, northing, zoneNumber, zoneLetter, northern...)
+}
 
-	if !zoneLetterExist && !northernExist {
-		err = inputError("either ZoneLetter or northern needs to be set")
+// ToLatLon convert Universal Transverse Mercator coordinates on the ellipsoid to a latitude and longitude.
+// See the package level ToLatLon.
+func (el *Ellipsoid) ToLatLon(
+	easting, northing float64,
+	zoneNumber int,
+	zoneLetter string,
+	northern ...bool) (
+	latitude, longitude float64, err error,
+) {
+	northernValue, err := parseToLatLonInput(easting, northing, zoneNumber, zoneLetter, northern...)
+	if err != nil {
 		return
-	} else if zoneLetterExist && northernExist {
-		err = inputError("set either ZoneLetter or northern, but not both")
+	}
+
+	latitude, longitude = el.toLatLon(easting, northing, zoneNumber, northernValue)
+
+	return
+}
+
+// toLatLon is ToLatLon without validation. The northing may lie outside the
+// hemisphere, as it does for points reprojected into a neighbouring zone.
+func (el *Ellipsoid) toLatLon(easting, northing float64, zoneNumber int, northern bool) (latitude, longitude float64) {
+	x := easting - 500000
+	y := northing
+
+	if !northern {
+		y -= 10000000
+	}
+
+	latRad, dLonRad := el.snyder.inverse(x/k0, y/k0)
+
+	latitude = deg(latRad)
+	longitude = deg(dLonRad) + float64(zoneNumberToCentralLongitude(zoneNumber))
+
+	return
+}
+
+// parseToLatLonInput validates the arguments of the ToLatLon family and
+// returns the hemisphere they describe.
+func parseToLatLonInput(
+	easting, northing float64,
+	zoneNumber int,
+	zoneLetter string,
+	northern ...bool) (
+	northernValue bool, err error,
+) {
+	northernValue, err = parseHemisphere(zoneLetter, northern...)
+	if err != nil {
 		return
 	}
 
+	err = validateUTM(easting, northing, zoneNumber)
+
+	return
+}
+
+// validateUTM checks the ranges of easting, northing and zone number.
+func validateUTM(easting, northing float64, zoneNumber int) error {
 	if !(100000 <= easting && easting < 1000000) {
-		err = inputError("easting out of range (must be between 100.000 m and 999.999 m")
-		return
+		return rangeError(ErrEastingOutOfRange, FieldEasting, easting, 100000, 1000000)
 	}
 
 	if !(0 <= northing && northing <= 10000000) {
-		err = inputError("northing out of range (must be between 0 m and 10.000.000 m)")
-		return
+		return rangeError(ErrNorthingOutOfRange, FieldNorthing, northing, 0, 10000000)
 	}
 
 	if !(1 <= zoneNumber && zoneNumber <= 60) {
-		err = inputError("zone number out of range (must be between 1 and 60)")
-		return
+		return rangeError(ErrZoneNumberOutOfRange, FieldZoneNumber, float64(zoneNumber), 1, 60)
 	}
 
-	var northernValue bool
+	return nil
+}
+
+// parseHemisphere validates a zone letter or northern flag, exactly one of
+// which must be set, and returns the hemisphere it describes.
+func parseHemisphere(zoneLetter string, northern ...bool) (northernValue bool, err error) {
+	northernExist := len(northern) > 0
+	zoneLetterExist := !(zoneLetter == "")
+
+	if !zoneLetterExist && !northernExist {
+		err = InputError{Err: ErrHemisphereMissing}
+		return
+	} else if zoneLetterExist && northernExist {
+		err = InputError{Err: ErrHemisphereAmbiguous}
+		return
+	}
 
 	if zoneLetterExist {
 		zoneLetterRune := unicode.ToUpper(rune(zoneLetter[0]))
 		if !('C' <= zoneLetterRune && zoneLetterRune <= 'X') || zoneLetterRune == 'I' || zoneLetterRune == 'O' {
-			err = inputError("zone letter out of range (must be between C and X)")
+			err = letterError(zoneLetter, 'C', 'X')
 			return
 		}
 		northernValue = zoneLetterRune >= 'N'
@@ -128,76 +158,58 @@ func ToLatLon(
 		northernValue = northern[0]
 	}
 
-	x := easting - 500000
-	y := northing
-
-	if !northernValue {
-		y -= 10000000
-	}
-
-	m := y / k0
-	mu := m / (r * m1)
-
-	pRad := mu +
-		p2*math.Sin(2*mu) +
-		p3*math.Sin(4*mu) +
-		p4*math.Sin(6*mu) +
-		p5*math.Sin(8*mu)
-
-	pSin := math.Sin(pRad)
-	pSin2 := pSin * pSin
-
-	pCos := math.Cos(pRad)
-
-	pTan := pSin / pCos
-	pTan2 := pTan * pTan
-	pTan4 := pTan2 * pTan2
-
-	epSin := 1 - e*pSin2
-	epSinSqrt := math.Sqrt(1 - e*pSin2)
-
-	n := r / epSinSqrt
-	rad_ := (1 - e) / epSin
-
-	c := eP2 * pCos * pCos
-	c2 := c * c
-
-	d := x / (n * k0)
-	d2 := d * d
-	d3 := d2 * d
-	d4 := d3 * d
-	d5 := d4 * d
-	d6 := d5 * d
-
-	latitude = pRad - (pTan/rad_)*
-		(d2/2-
-			d4/24*(5+3*pTan2+10*c-4*c2-9*eP2)+
-			d6/720*(61+90*pTan2+298*c+45*pTan4-252*eP2-3*c2))
-
-	longitude = (d -
-		d3/6*(1+2*pTan2+c) +
-		d5/120*(5-2*c+28*pTan2-3*c2+8*eP2+24*pTan4)) / pCos
-
-	latitude = deg(latitude)
-	longitude = deg(longitude) + float64(zoneNumberToCentralLongitude(zoneNumber))
-
 	return
 }
 
 // ValidateLatLone check that latitude and longitude are valid.
 func ValidateLatLone(latitude, longitude float64) error {
 	if !(-80.0 <= latitude && latitude <= 84.0) {
-		return inputError("latitude out of range (must be between 80 deg S and 84 deg N)")
+		return rangeError(ErrLatitudeOutOfRange, FieldLatitude, latitude, -80, 84)
 	}
 	if !(-180.0 <= longitude && longitude <= 180.0) {
-		return inputError("longitude out of range (must be between 180 deg W and 180 deg E)")
+		return rangeError(ErrLongitudeOutOfRange, FieldLongitude, longitude, -180, 180)
 	}
 	return nil
 }
 
 // FromLatLon convert a latitude and longitude to Universal Transverse Mercator coordinates.
+// With northern set to true the zone letter is "N" or "S" for the hemisphere
+// instead of the latitude band. Those are band letters as well, so don't feed
+// them back into ToLatLon; use FromLatLonHemisphere and ToLatLonHemisphere.
 func FromLatLon(latitude, longitude float64, northern bool) (
 	easting, northing float64, zoneNumber int, zoneLetter string, err error,
+) {
+	return WGS84.FromLatLon(latitude, longitude, northern)
+}
+
+// FromLatLon convert a latitude and longitude on the ellipsoid to Universal Transverse Mercator coordinates.
+// See the package level FromLatLon.
+func (el *Ellipsoid) FromLatLon(latitude, longitude float64, northern bool) (
+	easting, northing float64, zoneNumber int, zoneLetter string, err error,
+) {
+	longitude, zoneNumber, zoneLetter, err = parseFromLatLonInput(latitude, longitude, northern)
+	if err != nil {
+		return
+	}
+
+	centralLon := zoneNumberToCentralLongitude(zoneNumber)
+
+	x, y := el.snyder.forward(rad(latitude), rad(longitude-float64(centralLon)))
+
+	easting = k0*x + 500000
+	northing = k0 * y
+
+	if latitude < 0 {
+		northing += 10000000
+	}
+
+	return
+}
+
+// parseFromLatLonInput validates the arguments of the FromLatLon family and
+// selects the zone they fall into. The returned longitude is normalized.
+func parseFromLatLonInput(latitude, longitude float64, northern bool) (
+	normalizedLongitude float64, zoneNumber int, zoneLetter string, err error,
 ) {
 	// check that latitude and longitude are valid
 	err = ValidateLatLone(latitude, longitude)
@@ -211,17 +223,9 @@ func FromLatLon(latitude, longitude float64, northern bool) (
 	// meridian within one zone width (the raw 357deg offset of lon == 180 against
 	// zone 1's -177deg central meridian would otherwise push the easting series
 	// out of range and break the forward->inverse round-trip).
-	longitude = math.Mod(longitude+540, 360) - 180
-
-	latRad := rad(latitude)
-	latSin := math.Sin(latRad)
-	latCos := math.Cos(latRad)
+	normalizedLongitude = normalizeLongitude(longitude)
 
-	latTan := latSin / latCos
-	latTan2 := latTan * latTan
-	latTan4 := latTan2 * latTan2
-
-	zoneNumber = latLonToZoneNumber(latitude, longitude)
+	zoneNumber = latLonToZoneNumber(latitude, normalizedLongitude)
 
 	zoneLetter = latitudeToZoneLetter(latitude)
 
@@ -234,34 +238,6 @@ func FromLatLon(latitude, longitude float64, northern bool) (
 		}
 	}
 
-	lonRad := rad(longitude)
-	centralLon := zoneNumberToCentralLongitude(zoneNumber)
-	centralLonRad := rad(float64(centralLon))
-
-	n := r / math.Sqrt(1-e*latSin*latSin)
-	c := eP2 * latCos * latCos
-
-	a := latCos * (lonRad - centralLonRad)
-	a2 := a * a
-	a3 := a2 * a
-	a4 := a3 * a
-	a5 := a4 * a
-	a6 := a5 * a
-	m := r * (m1*latRad -
-		m2*math.Sin(2*latRad) +
-		m3*math.Sin(4*latRad) -
-		m4*math.Sin(6*latRad))
-	easting = k0*n*(a+
-		a3/6*(1-latTan2+c)+
-		a5/120*(5-18*latTan2+latTan4+72*c-58*eP2)) + 500000
-	northing = k0 * (m + n*latTan*(a2/2+
-		a4/24*(5-latTan2+9*c+4*c*c)+
-		a6/720*(61-58*latTan2+latTan4+600*c-330*eP2)))
-
-	if latitude < 0 {
-		northing += 10000000
-	}
-
 	return
 }
 
@@ -278,7 +254,7 @@ func latLonToZoneNumber(latitude float64, longitude float64) int {
 	// Normalize longitude to [-180, 180), matching the Python `utm` reference
 	// (the library this is a port of): lon == 180 wraps to -180 (zone 1) instead
 	// of the out-of-range zone 61.
-	longitude = math.Mod(longitude+540, 360) - 180
+	longitude = normalizeLongitude(longitude)
 
 	// MGRS band V is half-open [56, 64); at lat == 64 (band W) the Norway
 	// zone-32V western extension does not apply, so use latitude < 64.
@@ -306,13 +282,11 @@ func latLonToZoneNumber(latitude float64, longitude float64) int {
 	return int((longitude+180)/6) + 1
 }
 
-func zoneNumberToCentralLongitude(zoneNumber int) int {
-	return (zoneNumber-1)*6 - 180 + 3
+// normalizeLongitude wraps a longitude in degrees to [-180, 180).
+func normalizeLongitude(longitude float64) float64 {
+	return math.Mod(longitude+540, 360) - 180
 }
 
-// InputError allow to distinguish if an error is from UTM conversion functions.
-type InputError error
-
-func inputError(text string) InputError {
-	return InputError(errors.New(text))
+func zoneNumberToCentralLongitude(zoneNumber int) int {
+	return (zoneNumber-1)*6 - 180 + 3
 }
@@ -58,3 +58,73 @@ func BenchmarkFromLatLon(b *testing.B) {
 		}
 	}
 }
+
+const benchmarkBatchSize = 1024
+
+func BenchmarkToLatLonSlice(b *testing.B) {
+	coordinate := getBenchmarkCoordinate()
+
+	eastings := make([]float64, benchmarkBatchSize)
+	northings := make([]float64, benchmarkBatchSize)
+	zoneNumbers := make([]int, benchmarkBatchSize)
+	hemispheres := make([]UTM.Hemisphere, benchmarkBatchSize)
+	latitudes := make([]float64, benchmarkBatchSize)
+	longitudes := make([]float64, benchmarkBatchSize)
+	errs := make([]error, benchmarkBatchSize)
+
+	for i := range eastings {
+		eastings[i] = coordinate.Easting
+		northings[i] = coordinate.Northing
+		zoneNumbers[i] = coordinate.ZoneNumber
+		hemispheres[i] = UTM.Northern
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if failed, err := UTM.ToLatLonSlice(
+			eastings,
+			northings,
+			zoneNumbers,
+			hemispheres,
+			latitudes,
+			longitudes,
+			errs); err != nil || failed != 0 {
+			b.Fatal("benchmark fatal BenchmarkToLatLonSlice")
+		}
+	}
+}
+
+func BenchmarkFromLatLonSlice(b *testing.B) {
+	latLon := getLatLon()
+
+	latitudes := make([]float64, benchmarkBatchSize)
+	longitudes := make([]float64, benchmarkBatchSize)
+	eastings := make([]float64, benchmarkBatchSize)
+	northings := make([]float64, benchmarkBatchSize)
+	zoneNumbers := make([]int, benchmarkBatchSize)
+	bands := make([]byte, benchmarkBatchSize)
+	errs := make([]error, benchmarkBatchSize)
+
+	for i := range latitudes {
+		latitudes[i] = latLon.Latitude
+		longitudes[i] = latLon.Longitude
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if failed, err := UTM.FromLatLonSlice(
+			latitudes,
+			longitudes,
+			eastings,
+			northings,
+			zoneNumbers,
+			bands,
+			errs); err != nil || failed != 0 {
+			b.Fatal("benchmark fatal BenchmarkFromLatLonSlice")
+		}
+	}
+}
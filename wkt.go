@@ -0,0 +1,530 @@
+package UTM
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// wktDegree is the size of a degree in radians as written in WKT.
+const wktDegree = "0.0174532925199433"
+
+// datum names the geodetic datum of a CRS in the form each format wants.
+type datum struct {
+	geographic string // name of the geographic CRS, e.g. "WGS 84"
+	wkt1       string // WKT1 datum name, e.g. "WGS_1984"
+	wkt2       string // WKT2 datum name, e.g. "World Geodetic System 1984"
+}
+
+// datum returns the datum names of the CRS. Only WGS84 and the ETRS89 zones
+// have a known datum, other ellipsoids get a datum named after them.
+func (c CRS) datum() datum {
+	el := c.ellipsoid()
+
+	if el.sameAs(WGS84) {
+		return datum{"WGS 84", "WGS_1984", "World Geodetic System 1984"}
+	}
+
+	if el.sameAs(GRS80) && c.Hemisphere == Northern &&
+		etrs89MinZone <= c.ZoneNumber && c.ZoneNumber <= etrs89MaxZone {
+		return datum{"ETRS89", "European_Terrestrial_Reference_System_1989", "European Terrestrial Reference System 1989"}
+	}
+
+	return datum{el.Name(), "unknown", "Unknown based on " + el.Name() + " ellipsoid"}
+}
+
+func (c CRS) falseNorthing() float64 {
+	if c.Hemisphere == Southern {
+		return 10000000
+	}
+	return 0
+}
+
+// WKT1 returns the OGC WKT 1 definition of the CRS as written by GDAL, e.g.
+//
+//	PROJCS["WGS 84 / UTM zone 33N",GEOGCS["WGS 84",DATUM["WGS_1984",...
+//
+// The EPSG code is included as AUTHORITY if there is one.
+func (c CRS) WKT1() (string, error) {
+	if err := c.Validate(); err != nil {
+		return "", err
+	}
+
+	el := c.ellipsoid()
+	d := c.datum()
+
+	var b strings.Builder
+
+	b.WriteString(`PROJCS[` + wktQuote(c.String()) + `,`)
+	b.WriteString(`GEOGCS[` + wktQuote(d.geographic) + `,`)
+	b.WriteString(`DATUM[` + wktQuote(d.wkt1) + `,`)
+	b.WriteString(`SPHEROID[` + wktQuote(el.Name()) + `,` + formatFloat(el.a) + `,` + formatFloat(el.invF) + `]],`)
+	b.WriteString(`PRIMEM["Greenwich",0],`)
+	b.WriteString(`UNIT["degree",` + wktDegree + `]],`)
+	b.WriteString(`PROJECTION["Transverse_Mercator"],`)
+	b.WriteString(`PARAMETER["latitude_of_origin",0],`)
+	b.WriteString(`PARAMETER["central_meridian",` + strconv.Itoa(zoneNumberToCentralLongitude(c.ZoneNumber)) + `],`)
+	b.WriteString(`PARAMETER["scale_factor",` + formatFloat(k0) + `],`)
+	b.WriteString(`PARAMETER["false_easting",500000],`)
+	b.WriteString(`PARAMETER["false_northing",` + formatFloat(c.falseNorthing()) + `],`)
+	b.WriteString(`UNIT["metre",1],`)
+	b.WriteString(`AXIS["Easting",EAST],AXIS["Northing",NORTH]`)
+	if code, err := c.EPSG(); err == nil {
+		b.WriteString(`,AUTHORITY["EPSG","` + strconv.Itoa(code) + `"]`)
+	}
+	b.WriteString(`]`)
+
+	return b.String(), nil
+}
+
+// WKT2 returns the OGC WKT 2 (ISO 19162:2019) definition of the CRS, e.g.
+//
+//	PROJCRS["WGS 84 / UTM zone 33N",BASEGEOGCRS["WGS 84",DATUM[...
+//
+// The EPSG code is included as ID if there is one.
+func (c CRS) WKT2() (string, error) {
+	if err := c.Validate(); err != nil {
+		return "", err
+	}
+
+	el := c.ellipsoid()
+	d := c.datum()
+
+	const (
+		degree = `ANGLEUNIT["degree",` + wktDegree + `]`
+		metre  = `LENGTHUNIT["metre",1]`
+	)
+
+	var b strings.Builder
+
+	b.WriteString(`PROJCRS[` + wktQuote(c.String()) + `,`)
+	b.WriteString(`BASEGEOGCRS[` + wktQuote(d.geographic) + `,`)
+	b.WriteString(`DATUM[` + wktQuote(d.wkt2) + `,`)
+	b.WriteString(`ELLIPSOID[` + wktQuote(el.Name()) + `,` + formatFloat(el.a) + `,` + formatFloat(el.invF) + `,` + metre + `]],`)
+	b.WriteString(`PRIMEM["Greenwich",0,` + degree + `]],`)
+	b.WriteString(`CONVERSION[` + wktQuote(c.zoneName()) + `,`)
+	b.WriteString(`METHOD["Transverse Mercator",ID["EPSG",9807]],`)
+	b.WriteString(`PARAMETER["Latitude of natural origin",0,` + degree + `,ID["EPSG",8801]],`)
+	b.WriteString(`PARAMETER["Longitude of natural origin",` + strconv.Itoa(zoneNumberToCentralLongitude(c.ZoneNumber)) + `,` + degree + `,ID["EPSG",8802]],`)
+	b.WriteString(`PARAMETER["Scale factor at natural origin",` + formatFloat(k0) + `,SCALEUNIT["unity",1],ID["EPSG",8805]],`)
+	b.WriteString(`PARAMETER["False easting",500000,` + metre + `,ID["EPSG",8806]],`)
+	b.WriteString(`PARAMETER["False northing",` + formatFloat(c.falseNorthing()) + `,` + metre + `,ID["EPSG",8807]]],`)
+	b.WriteString(`CS[Cartesian,2],`)
+	b.WriteString(`AXIS["(E)",east,ORDER[1],` + metre + `],`)
+	b.WriteString(`AXIS["(N)",north,ORDER[2],` + metre + `]`)
+	if code, err := c.EPSG(); err == nil {
+		b.WriteString(`,ID["EPSG",` + strconv.Itoa(code) + `]`)
+	}
+	b.WriteString(`]`)
+
+	return b.String(), nil
+}
+
+// ParseWKT parses an OGC WKT 1 or WKT 2 projected CRS, including the ESRI
+// flavour of WKT 1. Definitions that are not a UTM zone in metres return an
+// error wrapping ErrUnsupportedCRS, malformed ones ErrInvalidCRS. The
+// ellipsoid is one of the predefined ones if the shape matches.
+func ParseWKT(s string) (CRS, error) {
+	p := wktParser{s: s}
+
+	root, err := p.parse()
+	if err != nil {
+		return CRS{}, err
+	}
+
+	switch strings.ToUpper(root.keyword) {
+	case "PROJCS":
+		return parseWKT1(root)
+	case "PROJCRS", "PROJECTEDCRS":
+		return parseWKT2(root)
+	default:
+		return CRS{}, inputError(ErrUnsupportedCRS, FieldNone, root.keyword+" is not a projected CRS")
+	}
+}
+
+func parseWKT1(root *wktNode) (CRS, error) {
+	geogcs := root.child("GEOGCS")
+	spheroid := geogcs.child("DATUM").child("SPHEROID")
+	if spheroid == nil {
+		return CRS{}, inputError(ErrInvalidCRS, FieldNone, "missing SPHEROID")
+	}
+
+	el, err := wktEllipsoid(spheroid, nil)
+	if err != nil {
+		return CRS{}, err
+	}
+
+	projection := root.child("PROJECTION")
+	if projection == nil {
+		return CRS{}, inputError(ErrInvalidCRS, FieldNone, "missing PROJECTION")
+	}
+
+	// angles are in the unit of GEOGCS, lengths in the unit of PROJCS
+	angleUnit, err := wktUnit(geogcs.child("UNIT"), xr)
+	if err != nil {
+		return CRS{}, err
+	}
+	lengthUnit, err := wktUnit(root.child("UNIT"), 1)
+	if err != nil {
+		return CRS{}, err
+	}
+
+	parameters := make(map[string]float64)
+	for _, parameter := range root.children("PARAMETER") {
+		value, ok := parameter.number(1)
+		if !ok {
+			return CRS{}, inputError(ErrInvalidCRS, FieldNone, "PARAMETER without value")
+		}
+		parameters[wktName(parameter.text(0))] = value
+	}
+
+	return utmCRS(el, wktName(projection.text(0)), lengthUnit,
+		deg(parameters["latitude_of_origin"]*angleUnit),
+		deg(parameters["central_meridian"]*angleUnit),
+		parameters["scale_factor"],
+		parameters["false_easting"]*lengthUnit,
+		parameters["false_northing"]*lengthUnit)
+}
+
+func parseWKT2(root *wktNode) (CRS, error) {
+	base := root.child("BASEGEOGCRS", "BASEGEODCRS")
+	ellipsoid := base.child("DATUM", "GEODETICDATUM", "TRF", "ENSEMBLE").child("ELLIPSOID", "SPHEROID")
+	if ellipsoid == nil {
+		return CRS{}, inputError(ErrInvalidCRS, FieldNone, "missing ELLIPSOID")
+	}
+
+	el, err := wktEllipsoid(ellipsoid, ellipsoid.child("LENGTHUNIT", "UNIT"))
+	if err != nil {
+		return CRS{}, err
+	}
+
+	conversion := root.child("CONVERSION")
+	method := conversion.child("METHOD", "PROJECTION")
+	if method == nil {
+		return CRS{}, inputError(ErrInvalidCRS, FieldNone, "missing METHOD")
+	}
+
+	// the axes carry the unit of the coordinates
+	lengthUnit := 1.0
+	for _, axis := range root.children("AXIS") {
+		if unit := axis.child("LENGTHUNIT", "UNIT"); unit != nil {
+			if lengthUnit, err = wktUnit(unit, 1); err != nil {
+				return CRS{}, err
+			}
+		}
+	}
+	if unit := root.child("LENGTHUNIT", "UNIT"); unit != nil {
+		if lengthUnit, err = wktUnit(unit, 1); err != nil {
+			return CRS{}, err
+		}
+	}
+
+	parameters := make(map[string]float64)
+	for _, parameter := range conversion.children("PARAMETER") {
+		value, ok := parameter.number(1)
+		if !ok {
+			return CRS{}, inputError(ErrInvalidCRS, FieldNone, "PARAMETER without value")
+		}
+
+		name := wktName(parameter.text(0))
+
+		// without unit angles are in degrees and lengths in metres
+		if unit := parameter.child("ANGLEUNIT", "LENGTHUNIT", "SCALEUNIT", "UNIT"); unit != nil {
+			factor, err := wktUnit(unit, 1)
+			if err != nil {
+				return CRS{}, err
+			}
+			value *= factor
+			if strings.HasPrefix(name, "latitude_") || strings.HasPrefix(name, "longitude_") {
+				value = deg(value)
+			}
+		}
+
+		parameters[name] = value
+	}
+
+	return utmCRS(el, wktName(method.text(0)), lengthUnit,
+		parameters["latitude_of_natural_origin"],
+		parameters["longitude_of_natural_origin"],
+		parameters["scale_factor_at_natural_origin"],
+		parameters["false_easting"],
+		parameters["false_northing"])
+}
+
+// utmCRS checks that Transverse Mercator parameters describe a UTM zone and
+// returns it. Angles are in degrees, lengths in metres.
+func utmCRS(
+	el *Ellipsoid,
+	method string,
+	lengthUnit float64,
+	latitudeOfOrigin, centralMeridian, scaleFactor, falseEasting, falseNorthing float64) (
+	CRS, error,
+) {
+	if method != "transverse_mercator" {
+		return CRS{}, inputError(ErrUnsupportedCRS, FieldNone, "projection "+strconv.Quote(method)+" is not Transverse Mercator")
+	}
+
+	if math.Abs(lengthUnit-1) > 1e-12 {
+		return CRS{}, inputError(ErrUnsupportedCRS, FieldNone, "unit is not metre")
+	}
+
+	zoneNumber := int(math.Round((centralMeridian + 183) / 6))
+
+	var hemisphere Hemisphere
+	switch {
+	case math.Abs(falseNorthing) < 1e-6:
+		hemisphere = Northern
+	case math.Abs(falseNorthing-10000000) < 1e-6:
+		hemisphere = Southern
+	}
+
+	if math.Abs(latitudeOfOrigin) > 1e-9 || math.Abs(scaleFactor-k0) > 1e-12 ||
+		math.Abs(falseEasting-500000) > 1e-6 || hemisphere == 0 ||
+		!(1 <= zoneNumber && zoneNumber <= 60) ||
+		math.Abs(centralMeridian-float64(zoneNumberToCentralLongitude(zoneNumber))) > 1e-9 {
+		return CRS{}, inputError(ErrUnsupportedCRS, FieldNone, "Transverse Mercator parameters are not a UTM zone")
+	}
+
+	return CRS{zoneNumber, hemisphere, el}, nil
+}
+
+// wktEllipsoid reads SPHEROID["name",a,1/f] or ELLIPSOID["name",a,1/f,unit].
+func wktEllipsoid(node, unit *wktNode) (*Ellipsoid, error) {
+	a, okA := node.number(1)
+	invF, okF := node.number(2)
+	if !okA || !okF {
+		return nil, inputError(ErrInvalidCRS, FieldNone, node.keyword+" without axis and flattening")
+	}
+
+	if unit != nil {
+		factor, err := wktUnit(unit, 1)
+		if err != nil {
+			return nil, err
+		}
+		a *= factor
+	}
+
+	return lookupEllipsoid(node.text(0), a, invF)
+}
+
+// wktUnit returns the conversion factor of UNIT["name",factor], or def for a
+// missing unit.
+func wktUnit(unit *wktNode, def float64) (float64, error) {
+	if unit == nil {
+		return def, nil
+	}
+
+	factor, ok := unit.number(1)
+	if !ok || !(factor > 0) {
+		return 0, inputError(ErrInvalidCRS, FieldNone, unit.keyword+" without factor")
+	}
+
+	return factor, nil
+}
+
+// wktQuote quotes a WKT string, a double quote is written twice.
+func wktQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// wktName normalizes "False Easting" and "False_Easting" to "false_easting".
+func wktName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, " ", "_"))
+}
+
+// wktNode is a WKT element KEYWORD[arg, ...]. An argument is a quoted
+// string, a number, an enumeration or a nested element.
+type wktNode struct {
+	keyword string
+	args    []wktArg
+}
+
+type wktArg struct {
+	node *wktNode
+	text string
+}
+
+// child returns the first nested element with one of the keywords, or nil.
+// It may be called on nil.
+func (n *wktNode) child(keywords ...string) *wktNode {
+	if n == nil {
+		return nil
+	}
+	for _, arg := range n.args {
+		if arg.node == nil {
+			continue
+		}
+		for _, keyword := range keywords {
+			if strings.EqualFold(arg.node.keyword, keyword) {
+				return arg.node
+			}
+		}
+	}
+	return nil
+}
+
+// children returns all nested elements with the keyword.
+func (n *wktNode) children(keyword string) []*wktNode {
+	if n == nil {
+		return nil
+	}
+	var nodes []*wktNode
+	for _, arg := range n.args {
+		if arg.node != nil && strings.EqualFold(arg.node.keyword, keyword) {
+			nodes = append(nodes, arg.node)
+		}
+	}
+	return nodes
+}
+
+// text returns argument i, or "" if it is missing or an element.
+func (n *wktNode) text(i int) string {
+	if n == nil || i >= len(n.args) {
+		return ""
+	}
+	return n.args[i].text
+}
+
+// number returns argument i as a number.
+func (n *wktNode) number(i int) (float64, bool) {
+	if n == nil || i >= len(n.args) || n.args[i].node != nil {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(n.args[i].text, 64)
+	return value, err == nil
+}
+
+// wktParser reads a WKT string into wktNodes.
+type wktParser struct {
+	s   string
+	pos int
+}
+
+func (p *wktParser) parse() (*wktNode, error) {
+	node, err := p.node()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	if p.pos != len(p.s) {
+		return nil, p.fail("unexpected text after the definition")
+	}
+
+	return node, nil
+}
+
+func (p *wktParser) node() (*wktNode, error) {
+	p.skipSpace()
+
+	keyword := p.token()
+	if keyword == "" {
+		return nil, p.fail("keyword expected")
+	}
+
+	p.skipSpace()
+	if p.pos == len(p.s) || (p.s[p.pos] != '[' && p.s[p.pos] != '(') {
+		return nil, p.fail("[ expected")
+	}
+	closing := byte(']')
+	if p.s[p.pos] == '(' {
+		closing = ')'
+	}
+	p.pos++
+
+	node := &wktNode{keyword: keyword}
+
+	for {
+		p.skipSpace()
+		if p.pos == len(p.s) {
+			return nil, p.fail("unterminated " + keyword)
+		}
+
+		var arg wktArg
+		switch {
+		case p.s[p.pos] == '"':
+			text, err := p.quoted()
+			if err != nil {
+				return nil, err
+			}
+			arg.text = text
+		default:
+			start := p.pos
+			text := p.token()
+			if text == "" {
+				return nil, p.fail("value expected")
+			}
+			p.skipSpace()
+			if p.pos < len(p.s) && (p.s[p.pos] == '[' || p.s[p.pos] == '(') {
+				p.pos = start
+				child, err := p.node()
+				if err != nil {
+					return nil, err
+				}
+				arg.node = child
+			} else {
+				arg.text = text
+			}
+		}
+		node.args = append(node.args, arg)
+
+		p.skipSpace()
+		if p.pos == len(p.s) {
+			return nil, p.fail("unterminated " + keyword)
+		}
+
+		switch p.s[p.pos] {
+		case ',':
+			p.pos++
+		case closing:
+			p.pos++
+			return node, nil
+		default:
+			return nil, p.fail(", or " + string(closing) + " expected")
+		}
+	}
+}
+
+// token reads a keyword, number or enumeration.
+func (p *wktParser) token() string {
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte(`[](),"`, p.s[p.pos]) < 0 && !isWKTSpace(p.s[p.pos]) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+// quoted reads a string in double quotes, "" is an escaped quote.
+func (p *wktParser) quoted() (string, error) {
+	var b strings.Builder
+
+	for p.pos++; p.pos < len(p.s); p.pos++ {
+		if p.s[p.pos] != '"' {
+			b.WriteByte(p.s[p.pos])
+			continue
+		}
+		if p.pos+1 < len(p.s) && p.s[p.pos+1] == '"' {
+			b.WriteByte('"')
+			p.pos++
+			continue
+		}
+		p.pos++
+		return b.String(), nil
+	}
+
+	return "", p.fail("unterminated string")
+}
+
+func (p *wktParser) skipSpace() {
+	for p.pos < len(p.s) && isWKTSpace(p.s[p.pos]) {
+		p.pos++
+	}
+}
+
+func isWKTSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func (p *wktParser) fail(message string) error {
+	return inputError(ErrInvalidCRS, FieldNone, message+" at offset "+strconv.Itoa(p.pos))
+}
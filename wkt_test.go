@@ -0,0 +1,224 @@
+package UTM_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/im7mortal/UTM"
+)
+
+// as written by GDAL
+const wkt1UTM33N = `PROJCS["WGS 84 / UTM zone 33N",
+    GEOGCS["WGS 84",
+        DATUM["WGS_1984",
+            SPHEROID["WGS 84",6378137,298.257223563,
+                AUTHORITY["EPSG","7030"]],
+            AUTHORITY["EPSG","6326"]],
+        PRIMEM["Greenwich",0,
+            AUTHORITY["EPSG","8901"]],
+        UNIT["degree",0.0174532925199433,
+            AUTHORITY["EPSG","9122"]],
+        AUTHORITY["EPSG","4326"]],
+    PROJECTION["Transverse_Mercator"],
+    PARAMETER["latitude_of_origin",0],
+    PARAMETER["central_meridian",15],
+    PARAMETER["scale_factor",0.9996],
+    PARAMETER["false_easting",500000],
+    PARAMETER["false_northing",0],
+    UNIT["metre",1,
+        AUTHORITY["EPSG","9001"]],
+    AXIS["Easting",EAST],
+    AXIS["Northing",NORTH],
+    AUTHORITY["EPSG","32633"]]`
+
+// as written by ESRI software
+const wkt1ESRIUTM33S = `PROJCS["WGS_1984_UTM_Zone_33S",GEOGCS["GCS_WGS_1984",DATUM["D_WGS_1984",` +
+	`SPHEROID["WGS_1984",6378137.0,298.257223563]],PRIMEM["Greenwich",0.0],UNIT["Degree",0.0174532925199433]],` +
+	`PROJECTION["Transverse_Mercator"],PARAMETER["False_Easting",500000.0],PARAMETER["False_Northing",10000000.0],` +
+	`PARAMETER["Central_Meridian",15.0],PARAMETER["Scale_Factor",0.9996],PARAMETER["Latitude_Of_Origin",0.0],` +
+	`UNIT["Meter",1.0]]`
+
+// as written by PROJ
+const wkt2UTM33N = `PROJCRS["WGS 84 / UTM zone 33N",
+    BASEGEOGCRS["WGS 84",
+        ENSEMBLE["World Geodetic System 1984 ensemble",
+            MEMBER["World Geodetic System 1984 (Transit)"],
+            MEMBER["World Geodetic System 1984 (G730)"],
+            ELLIPSOID["WGS 84",6378137,298.257223563,
+                LENGTHUNIT["metre",1]],
+            ENSEMBLEACCURACY[2.0]],
+        PRIMEM["Greenwich",0,
+            ANGLEUNIT["degree",0.0174532925199433]],
+        ID["EPSG",4326]],
+    CONVERSION["UTM zone 33N",
+        METHOD["Transverse Mercator",
+            ID["EPSG",9807]],
+        PARAMETER["Latitude of natural origin",0,
+            ANGLEUNIT["degree",0.0174532925199433],
+            ID["EPSG",8801]],
+        PARAMETER["Longitude of natural origin",15,
+            ANGLEUNIT["degree",0.0174532925199433],
+            ID["EPSG",8802]],
+        PARAMETER["Scale factor at natural origin",0.9996,
+            SCALEUNIT["unity",1],
+            ID["EPSG",8805]],
+        PARAMETER["False easting",500000,
+            LENGTHUNIT["metre",1],
+            ID["EPSG",8806]],
+        PARAMETER["False northing",0,
+            LENGTHUNIT["metre",1],
+            ID["EPSG",8807]]],
+    CS[Cartesian,2],
+        AXIS["(E)",east,
+            ORDER[1],
+            LENGTHUNIT["metre",1]],
+        AXIS["(N)",north,
+            ORDER[2],
+            LENGTHUNIT["metre",1]],
+    USAGE[
+        SCOPE["Navigation and medium accuracy spatial referencing."],
+        AREA["Between 12°E and 18°E, northern hemisphere between equator and 84°N, onshore and offshore."],
+        BBOX[0,12,84,18]],
+    ID["EPSG",32633]]`
+
+func TestParseWKT(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		wkt  string
+		want UTM.CRS
+	}{
+		{wkt1UTM33N, UTM.CRS{ZoneNumber: 33, Hemisphere: UTM.Northern, Ellipsoid: UTM.WGS84}},
+		{wkt1ESRIUTM33S, UTM.CRS{ZoneNumber: 33, Hemisphere: UTM.Southern, Ellipsoid: UTM.WGS84}},
+		{wkt2UTM33N, UTM.CRS{ZoneNumber: 33, Hemisphere: UTM.Northern, Ellipsoid: UTM.WGS84}},
+	}
+
+	for i, c := range cases {
+		crs, err := UTM.ParseWKT(c.wkt)
+		if err != nil || crs != c.want {
+			t.Errorf("case %d: %v %v, want %v", i, crs, err, c.want)
+		}
+	}
+}
+
+func TestWKTRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	custom, err := UTM.NewEllipsoid(`My "Local" Ellipsoid`, 6378000, 298)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	crss := []UTM.CRS{
+		{ZoneNumber: 33, Hemisphere: UTM.Northern, Ellipsoid: UTM.WGS84},
+		{ZoneNumber: 1, Hemisphere: UTM.Southern, Ellipsoid: UTM.WGS84},
+		{ZoneNumber: 32, Hemisphere: UTM.Northern, Ellipsoid: UTM.GRS80},
+		{ZoneNumber: 60, Hemisphere: UTM.Southern, Ellipsoid: UTM.International1924},
+		{ZoneNumber: 17, Hemisphere: UTM.Northern, Ellipsoid: custom},
+	}
+
+	for i, crs := range crss {
+		for _, write := range []func() (string, error){crs.WKT1, crs.WKT2} {
+			wkt, err := write()
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			parsed, err := UTM.ParseWKT(wkt)
+			if err != nil {
+				t.Errorf("case %d: %v\n%s", i, err, wkt)
+				continue
+			}
+
+			if parsed.ZoneNumber != crs.ZoneNumber || parsed.Hemisphere != crs.Hemisphere ||
+				parsed.Ellipsoid.SemiMajorAxis() != crs.Ellipsoid.SemiMajorAxis() ||
+				parsed.Ellipsoid.InverseFlattening() != crs.Ellipsoid.InverseFlattening() ||
+				parsed.Ellipsoid.Name() != crs.Ellipsoid.Name() {
+				t.Errorf("case %d: %v, want %v", i, parsed, crs)
+			}
+		}
+	}
+}
+
+func TestWKT(t *testing.T) {
+	t.Parallel()
+
+	crs := UTM.CRS{ZoneNumber: 32, Hemisphere: UTM.Northern, Ellipsoid: UTM.GRS80}
+
+	wkt1, err := crs.WKT1()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for _, part := range []string{
+		`PROJCS["ETRS89 / UTM zone 32N",GEOGCS["ETRS89",DATUM["European_Terrestrial_Reference_System_1989",SPHEROID["GRS 1980",6378137,298.257222101]]`,
+		`PARAMETER["central_meridian",9]`,
+		`AUTHORITY["EPSG","25832"]]`,
+	} {
+		if !strings.Contains(wkt1, part) {
+			t.Errorf("%s does not contain %s", wkt1, part)
+		}
+	}
+
+	wkt2, err := crs.WKT2()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for _, part := range []string{
+		`PROJCRS["ETRS89 / UTM zone 32N",BASEGEOGCRS["ETRS89",DATUM["European Terrestrial Reference System 1989"`,
+		`CONVERSION["UTM zone 32N",METHOD["Transverse Mercator",ID["EPSG",9807]]`,
+		`ID["EPSG",25832]]`,
+	} {
+		if !strings.Contains(wkt2, part) {
+			t.Errorf("%s does not contain %s", wkt2, part)
+		}
+	}
+
+	// no EPSG code
+	crs = UTM.CRS{ZoneNumber: 32, Hemisphere: UTM.Northern, Ellipsoid: UTM.Bessel1841}
+	if wkt1, _ := crs.WKT1(); strings.Contains(wkt1, "AUTHORITY") {
+		t.Errorf("%s contains an AUTHORITY", wkt1)
+	}
+
+	if _, err := (UTM.CRS{ZoneNumber: 0, Hemisphere: UTM.Northern}).WKT2(); !errors.Is(err, UTM.ErrZoneNumberOutOfRange) {
+		t.Errorf("WKT2: %v", err)
+	}
+}
+
+func TestParseWKTErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		wkt      string
+		sentinel error
+	}{
+		{`GEOGCS["WGS 84",DATUM["WGS_1984",SPHEROID["WGS 84",6378137,298.257223563]],PRIMEM["Greenwich",0],UNIT["degree",0.0174532925199433]]`, UTM.ErrUnsupportedCRS},
+		{strings.Replace(wkt1UTM33N, "Transverse_Mercator", "Lambert_Conformal_Conic_2SP", 1), UTM.ErrUnsupportedCRS},
+		{strings.Replace(wkt1UTM33N, `"central_meridian",15`, `"central_meridian",16`, 1), UTM.ErrUnsupportedCRS},
+		{strings.Replace(wkt1UTM33N, `"scale_factor",0.9996`, `"scale_factor",1`, 1), UTM.ErrUnsupportedCRS},
+		{strings.Replace(wkt1UTM33N, `"false_northing",0`, `"false_northing",5000000`, 1), UTM.ErrUnsupportedCRS},
+		{strings.Replace(wkt1UTM33N, `UNIT["metre",1,`, `UNIT["US survey foot",0.304800609601219,`, 1), UTM.ErrUnsupportedCRS},
+		{strings.Replace(wkt2UTM33N, `"Longitude of natural origin",15`, `"Longitude of natural origin",14`, 1), UTM.ErrUnsupportedCRS},
+		{"", UTM.ErrInvalidCRS},
+		{`PROJCS["x"`, UTM.ErrInvalidCRS},
+		{`PROJCS["x]`, UTM.ErrInvalidCRS},
+		{`PROJCS["x"] x`, UTM.ErrInvalidCRS},
+		{`PROJCS["x";1]`, UTM.ErrInvalidCRS},
+		{`PROJCS["x",PROJECTION["Transverse_Mercator"]]`, UTM.ErrInvalidCRS},
+		{strings.Replace(wkt1UTM33N, `"central_meridian",15`, `"central_meridian",x`, 1), UTM.ErrInvalidCRS},
+	}
+
+	for i, c := range cases {
+		_, err := UTM.ParseWKT(c.wkt)
+		if !errors.Is(err, c.sentinel) {
+			t.Errorf("case %d: %v is not %v", i, err, c.sentinel)
+		}
+
+		var inputErr UTM.InputError
+		if !errors.As(err, &inputErr) {
+			t.Errorf("case %d: %v is not an InputError", i, err)
+		}
+	}
+}